@@ -0,0 +1,49 @@
+package validations
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var abaRegex = regexp.MustCompile(`^[0-9]{9}$`)
+
+// ValidateABA validates an ABA routing transit number. Numeric values are
+// zero padded to nine digits.
+func ValidateABA(value interface{}) (string, string, error) {
+	var v string
+	switch n := value.(type) {
+	case string:
+		v = strings.TrimSpace(n)
+	case int64:
+		v = fmt.Sprintf("%09d", n)
+	case float64:
+		if n != math.Trunc(n) || n < 0 {
+			return "", "", errNotString(ABA, value)
+		}
+		v = fmt.Sprintf("%09.0f", n)
+	default:
+		return "", "", errNotString(ABA, value)
+	}
+
+	if e := matchRegEx(ABA, abaRegex, v); e != nil {
+		return "", "", e
+	}
+
+	prefix, _ := strconv.Atoi(v[:2])
+	if !(prefix <= 12 || prefix >= 21 && prefix <= 32 || prefix >= 61 && prefix <= 72 || prefix == 80) {
+		return "", "", newValidationError(ABA, CodeInvalid, value, "invalid routing number prefix: %s", v[:2])
+	}
+
+	sum := 0
+	for i, weight := range []int{3, 7, 1, 3, 7, 1, 3, 7, 1} {
+		sum += int(v[i]-'0') * weight
+	}
+	if sum%10 != 0 {
+		return "", "", newValidationError(ABA, CodeBadChecksum, value, "invalid routing number checksum: %s", v)
+	}
+
+	return v, GenerateSHA3256(v), nil
+}
@@ -0,0 +1,43 @@
+package validations
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateABA(t *testing.T) {
+	var validNumbers = map[interface{}]string{
+		"011000015":       "011000015",
+		"021000021":       "021000021",
+		" 322271627 ":     "322271627",
+		int64(11000015):   "011000015",
+		float64(21000021): "021000021",
+	}
+
+	for in, out := range validNumbers {
+		v, _, err := ValidateABA(in)
+		if err != nil {
+			t.Errorf("%v: %v", in, err)
+		}
+
+		if v != out {
+			t.Errorf("expected %s, got %s", out, v)
+		}
+	}
+
+	var invalidNumbers = map[interface{}]string{
+		"021000022":  CodeBadChecksum,
+		"02100002":   CodeRegexMismatch,
+		"0210000211": CodeRegexMismatch,
+		"131000016":  CodeInvalid,
+		1.5:          CodeNotString,
+	}
+
+	for in, code := range invalidNumbers {
+		_, _, err := ValidateValue(in, "aba-rtn")
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Code != code {
+			t.Errorf("%v: expected %s, got %v", in, code, err)
+		}
+	}
+}
@@ -1,9 +1,5 @@
 package validations
 
-import (
-	"fmt"
-)
-
 func ValidateAdversary(value interface{}) (string, string, error) {
 	_, _, e1 := ValidateURL(value)
 
@@ -18,12 +14,12 @@ func ValidateAdversary(value interface{}) (string, string, error) {
 	_, _, e7 := ValidateFQDN(value)
 
 	if e1 == nil || e3 == nil || e4 == nil || e5 == nil || e6 == nil || e7 == nil {
-		return "", "", fmt.Errorf("invalid adversary: %v", value)
+		return "", "", newValidationError(ADVERSARY, CodeInvalid, value, "invalid adversary: %v", value)
 	}
 
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(ADVERSARY, value)
 	}
 
 	return v, GenerateSHA3256(v), nil
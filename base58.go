@@ -0,0 +1,110 @@
+package validations
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/bits"
+	"strings"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+func base58Decode(s string) ([]byte, bool) {
+	if s == "" {
+		return nil, false
+	}
+
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	var out []byte
+	for i := zeros; i < len(s); i++ {
+		carry := strings.IndexByte(base58Alphabet, s[i])
+		if carry < 0 {
+			return nil, false
+		}
+
+		for j := len(out) - 1; j >= 0; j-- {
+			carry += int(out[j]) * 58
+			out[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			out = append([]byte{byte(carry)}, out...)
+			carry >>= 8
+		}
+	}
+
+	return append(make([]byte, zeros), out...), true
+}
+
+// base58CheckDecode decodes a Base58Check string and returns its payload,
+// version byte included, without the checksum.
+func base58CheckDecode(dataType, s string) ([]byte, error) {
+	b, ok := base58Decode(s)
+	if !ok || len(b) < 5 {
+		return nil, newValidationError(dataType, CodeInvalid, s, "invalid base58 value: %s", s)
+	}
+
+	payload, checksum := b[:len(b)-4], b[len(b)-4:]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(second[:4], checksum) {
+		return nil, newValidationError(dataType, CodeBadChecksum, s, "invalid base58 checksum: %s", s)
+	}
+
+	return payload, nil
+}
+
+var moneroEncodedBlockSizes = []int{0, 2, 3, 5, 6, 7, 9, 10, 11}
+
+// moneroBase58Decode decodes the Monero flavour of base58, which encodes
+// blocks of 8 bytes into 11 characters.
+func moneroBase58Decode(s string) ([]byte, bool) {
+	var out []byte
+	for len(s) > 0 {
+		n := 11
+		if len(s) < n {
+			n = len(s)
+		}
+
+		size := -1
+		for i, encoded := range moneroEncodedBlockSizes {
+			if encoded == n {
+				size = i
+			}
+		}
+		if size <= 0 {
+			return nil, false
+		}
+
+		var num uint64
+		for i := 0; i < n; i++ {
+			digit := strings.IndexByte(base58Alphabet, s[i])
+			if digit < 0 {
+				return nil, false
+			}
+
+			hi, lo := bits.Mul64(num, 58)
+			if hi != 0 || lo+uint64(digit) < lo {
+				return nil, false
+			}
+			num = lo + uint64(digit)
+		}
+		if size < 8 && num>>(8*uint(size)) != 0 {
+			return nil, false
+		}
+
+		block := make([]byte, size)
+		for i := size - 1; i >= 0; i-- {
+			block[i] = byte(num)
+			num >>= 8
+		}
+		out = append(out, block...)
+		s = s[n:]
+	}
+
+	return out, true
+}
@@ -2,17 +2,16 @@ package validations
 
 import (
 	"encoding/base64"
-	"fmt"
 )
 
 func ValidateBase64(value interface{}) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(BASE64, value)
 	}
 	_, err := base64.StdEncoding.DecodeString(v)
 	if err != nil {
-		return "", "", err
+		return "", "", wrapValidationError(BASE64, CodeInvalid, value, err)
 	}
 
 	return v, GenerateSHA3256(v), nil
@@ -0,0 +1,31 @@
+package validations
+
+import (
+	"regexp"
+	"strings"
+)
+
+var bicRegex = regexp.MustCompile(`^[A-Z0-9]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// ValidateBIC validates an ISO 9362 business identifier code. The primary
+// office branch code XXX is dropped so both forms share the same ID.
+func ValidateBIC(value interface{}) (string, string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return "", "", errNotString(BIC, value)
+	}
+
+	bic := strings.ToUpper(strings.TrimSpace(v))
+
+	if e := matchRegEx(BIC, bicRegex, bic); e != nil {
+		return "", "", e
+	}
+
+	if _, ok := countriesByCode[bic[4:6]]; !ok {
+		return "", "", newValidationError(BIC, CodeInvalid, value, "invalid BIC country code: %s", bic[4:6])
+	}
+
+	bic = strings.TrimSuffix(bic, "XXX")
+
+	return bic, GenerateSHA3256(bic), nil
+}
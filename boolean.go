@@ -7,7 +7,7 @@ import (
 func ValidateBoolean(value interface{}) (bool, string, error) {
 	v, ok := value.(bool)
 	if !ok {
-		return false, "", fmt.Errorf("value is not boolean: %v", value)
+		return false, "", newValidationError(BOOLEAN, CodeNotBoolean, value, "value is not boolean: %v", value)
 	}
 
 	return v, GenerateSHA3256(fmt.Sprint(v)), nil
@@ -0,0 +1,90 @@
+package validations
+
+import (
+	"strings"
+)
+
+var btcVersions = map[byte]CryptoAddress{
+	0x00: {Network: Mainnet, Kind: "p2pkh"},
+	0x05: {Network: Mainnet, Kind: "p2sh"},
+	0x6f: {Network: Testnet, Kind: "p2pkh"},
+	0xc4: {Network: Testnet, Kind: "p2sh"},
+}
+
+var btcHRPs = map[string]string{
+	"bc":   Mainnet,
+	"tb":   Testnet,
+	"bcrt": Regtest,
+}
+
+// ParseBTCAddress parses a legacy Base58Check or a SegWit Bech32/Bech32m
+// Bitcoin address.
+func ParseBTCAddress(s string) (CryptoAddress, error) {
+	if i := strings.LastIndexByte(s, '1'); i > 0 {
+		if _, ok := btcHRPs[strings.ToLower(s[:i])]; ok {
+			return parseBTCSegwitAddress(s)
+		}
+	}
+
+	payload, err := base58CheckDecode(BTC, s)
+	if err != nil {
+		return CryptoAddress{}, err
+	}
+
+	addr, ok := btcVersions[payload[0]]
+	if !ok || len(payload) != 21 {
+		return CryptoAddress{}, newValidationError(BTC, CodeInvalid, s, "invalid bitcoin address: %s", s)
+	}
+
+	addr.Address = s
+	return addr, nil
+}
+
+func parseBTCSegwitAddress(s string) (CryptoAddress, error) {
+	hrp, data, constant, err := bech32Decode(BTC, s)
+	if err != nil {
+		return CryptoAddress{}, err
+	}
+
+	if len(data) < 1 || data[0] > 16 {
+		return CryptoAddress{}, newValidationError(BTC, CodeInvalid, s, "invalid witness version: %s", s)
+	}
+
+	version := data[0]
+	program, ok := convertBits(data[1:], 5, 8, false)
+	if !ok || len(program) < 2 || len(program) > 40 {
+		return CryptoAddress{}, newValidationError(BTC, CodeInvalid, s, "invalid witness program: %s", s)
+	}
+
+	if version == 0 && constant != bech32Const || version != 0 && constant != bech32mConst {
+		return CryptoAddress{}, newValidationError(BTC, CodeBadChecksum, s, "invalid checksum variant for witness version %d: %s", version, s)
+	}
+
+	addr := CryptoAddress{Address: strings.ToLower(s), Network: btcHRPs[hrp], Kind: "witness"}
+	switch {
+	case version == 0 && len(program) == 20:
+		addr.Kind = "p2wpkh"
+	case version == 0 && len(program) == 32:
+		addr.Kind = "p2wsh"
+	case version == 0:
+		return CryptoAddress{}, newValidationError(BTC, CodeInvalid, s, "invalid witness program length: %s", s)
+	case version == 1 && len(program) == 32:
+		addr.Kind = "p2tr"
+	}
+
+	return addr, nil
+}
+
+func ValidateBTC(value interface{}) (string, string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return "", "", errNotString(BTC, value)
+	}
+
+	addr, err := ParseBTCAddress(v)
+	if err != nil {
+		return "", "", err
+	}
+
+	return addr.Address, GenerateSHA3256(addr.Address), nil
+}
@@ -0,0 +1,174 @@
+package validations
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	Visa            = "Visa"
+	Mastercard      = "Mastercard"
+	AmericanExpress = "American Express"
+	Discover        = "Discover"
+	JCB             = "JCB"
+	DinersClub      = "Diners Club"
+	UnionPay        = "UnionPay"
+	Maestro         = "Maestro"
+	Mir             = "Mir"
+)
+
+type cardRange struct {
+	scheme   string
+	from, to int
+	lengths  []int
+}
+
+// cardRanges maps issuer identification number ranges to card schemes. The
+// ranges are compared against a prefix of the same length as from, so more
+// specific ranges come first.
+var cardRanges = []cardRange{
+	{Discover, 622126, 622925, []int{16, 17, 18, 19}},
+	{Maestro, 5018, 5018, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{Maestro, 5020, 5020, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{Maestro, 5038, 5038, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{Maestro, 5893, 5893, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{Maestro, 6304, 6304, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{Maestro, 6759, 6759, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{Maestro, 6761, 6763, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{Mir, 2200, 2204, []int{16, 17, 18, 19}},
+	{Mastercard, 2221, 2720, []int{16}},
+	{DinersClub, 3095, 3095, []int{14, 15, 16, 17, 18, 19}},
+	{JCB, 3528, 3589, []int{16, 17, 18, 19}},
+	{Discover, 6011, 6011, []int{16, 17, 18, 19}},
+	{DinersClub, 300, 305, []int{14, 15, 16, 17, 18, 19}},
+	{Discover, 644, 649, []int{16, 17, 18, 19}},
+	{AmericanExpress, 34, 34, []int{15}},
+	{AmericanExpress, 37, 37, []int{15}},
+	{DinersClub, 36, 36, []int{14, 15, 16, 17, 18, 19}},
+	{DinersClub, 38, 39, []int{14, 15, 16, 17, 18, 19}},
+	{Mastercard, 51, 55, []int{16}},
+	{Discover, 65, 65, []int{16, 17, 18, 19}},
+	{UnionPay, 62, 62, []int{16, 17, 18, 19}},
+	{Visa, 4, 4, []int{13, 16, 19}},
+}
+
+// DetectCardScheme returns the scheme of a card number from its issuer
+// identification number and length, or an empty string if it is unknown.
+// Only the first six digits are used so masked numbers are supported.
+func DetectCardScheme(pan string) string {
+	for _, r := range cardRanges {
+		digits := len(strconv.Itoa(r.from))
+		if len(pan) < digits {
+			continue
+		}
+
+		prefix, err := strconv.Atoi(pan[:digits])
+		if err != nil || prefix < r.from || prefix > r.to {
+			continue
+		}
+
+		for _, l := range r.lengths {
+			if l == len(pan) {
+				return r.scheme
+			}
+		}
+	}
+	return ""
+}
+
+func luhn(pan string) bool {
+	sum := 0
+	double := false
+	for i := len(pan) - 1; i >= 0; i-- {
+		d := int(pan[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// MaskCardNumber keeps the first six and last four digits of a card number.
+func MaskCardNumber(pan string) string {
+	if len(pan) <= 10 {
+		return pan
+	}
+	return pan[:6] + strings.Repeat("*", len(pan)-10) + pan[len(pan)-4:]
+}
+
+func cardDigits(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(v)), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		if v != math.Trunc(v) || v < 0 || v > 1<<53 {
+			return "", false
+		}
+		return strconv.FormatFloat(v, 'f', 0, 64), true
+	}
+	return "", false
+}
+
+// ValidateCardNumber validates a payment card number, which may be grouped
+// with spaces or dashes, and returns its digits.
+func ValidateCardNumber(value interface{}) (string, string, error) {
+	pan, ok := cardDigits(value)
+	if !ok {
+		return "", "", errNotString(CARD, value)
+	}
+
+	if len(pan) < 12 || len(pan) > 19 {
+		return "", "", newValidationError(CARD, CodeInvalid, MaskCardNumber(pan), "invalid card number length: %d", len(pan))
+	}
+
+	for _, c := range pan {
+		if c < '0' || c > '9' {
+			masked := MaskCardNumber(pan)
+			return "", "", newValidationError(CARD, CodeInvalid, masked, "card number must contain only digits: %s", masked)
+		}
+	}
+
+	if !luhn(pan) {
+		return "", "", newValidationError(CARD, CodeBadChecksum, MaskCardNumber(pan), "invalid card number checksum")
+	}
+
+	return pan, GenerateSHA3256(pan), nil
+}
+
+func validateCardNumber(value interface{}, opts Options) (string, string, error) {
+	pan, id, err := ValidateCardNumber(value)
+	if err != nil || !opts.MaskCardNumber {
+		return pan, id, err
+	}
+
+	// A hash of the full number next to its first six and last four digits
+	// could be brute forced, so the ID is derived from the masked form.
+	masked := MaskCardNumber(pan)
+	return masked, GenerateSHA3256(masked), nil
+}
+
+// cardIssuerHook fills the issuer attribute of card numbers with the card
+// scheme when it is missing.
+func cardIssuerHook(entity *Entity) []error {
+	if _, ok := entity.Attributes[issuer.Type]; ok {
+		return nil
+	}
+
+	pan, ok := entity.Attributes[entity.Type].(string)
+	if !ok {
+		return nil
+	}
+
+	if scheme := DetectCardScheme(pan); scheme != "" {
+		entity.Attributes[issuer.Type] = strings.ToLower(scheme)
+	}
+	return nil
+}
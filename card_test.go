@@ -0,0 +1,77 @@
+package validations
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateCardNumber(t *testing.T) {
+	var validCards = map[string]string{
+		"4111 1111 1111 1111": Visa,
+		"4012-8888-8888-1881": Visa,
+		"5555555555554444":    Mastercard,
+		"2223003122003222":    Mastercard,
+		"378282246310005":     AmericanExpress,
+		"3714 496353 98431":   AmericanExpress,
+		"6011111111111117":    Discover,
+		"30569309025904":      DinersClub,
+		"3530111333300000":    JCB,
+		"6200000000000005":    UnionPay,
+		"6759649826438453":    Maestro,
+	}
+
+	for in, scheme := range validCards {
+		pan, _, err := ValidateCardNumber(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+
+		if s := DetectCardScheme(pan); s != scheme {
+			t.Errorf("%s: expected %s, got %s", in, scheme, s)
+		}
+	}
+
+	var invalidCards = map[interface{}]string{
+		"4111 1111 1111 1112": CodeBadChecksum,
+		"4111 1111 111":       CodeInvalid,
+		"4111 1111 1111 111a": CodeInvalid,
+		true:                  CodeNotString,
+	}
+
+	for in, code := range invalidCards {
+		_, _, err := ValidateCardNumber(in)
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Code != code {
+			t.Errorf("%v: expected %s, got %v", in, code, err)
+		}
+	}
+
+	masked, id, err := ValidateValue("4111 1111 1111 1111", "cc-number", WithCardNumberMasking())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if masked != "411111******1111" || id != GenerateSHA3256("411111******1111") {
+		t.Errorf("unexpected masked card %v %s", masked, id)
+	}
+
+	_, _, err = ValidateCardNumber("4111 1111 1111 111x")
+	var verr *ValidationError
+	if !errors.As(err, &verr) || strings.Contains(verr.Error(), "1111111") || verr.Value != "411111******111x" {
+		t.Errorf("card number was not redacted: %v %v", err, verr.Value)
+	}
+
+	entity, errs := ValidateEntity(Entity{
+		Type:       "cc-number",
+		Attributes: map[string]interface{}{"cc-number": "5555 5555 5555 4444"},
+	}, WithCardNumberMasking())
+	for _, err := range errs {
+		t.Error(err)
+	}
+
+	if entity.Attributes["issuer"] != "mastercard" {
+		t.Errorf("issuer was not filled: %v", entity.Attributes)
+	}
+}
@@ -1,26 +1,35 @@
 package validations
 
 import (
-	"fmt"
 	"net"
+	"net/netip"
 	"strings"
 )
 
-func ValidateCIDR(value interface{}) (string, string, error) {
+func ValidateCIDR(value interface{}, policy ...IPPolicy) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(CIDR, value)
 	}
-	ip, cidr, err := net.ParseCIDR(strings.ToLower(v))
+	_, cidr, err := net.ParseCIDR(strings.ToLower(v))
 	if err != nil {
-		return "", "", err
+		return "", "", wrapValidationError(CIDR, CodeInvalid, value, err)
 	}
 
-	_, _, e := ValidateIP(ip.String())
-	if e != nil {
-		return "", "", e
+	p, err := ipPolicy(policy)
+	if err != nil {
+		return "", "", wrapValidationError(CIDR, CodeInvalid, value, err)
 	}
 
 	cstr := cidr.String()
+	prefix, err := netip.ParsePrefix(cstr)
+	if err != nil {
+		return "", "", wrapValidationError(CIDR, CodeInvalid, value, err)
+	}
+
+	if e := p.check(prefix, value); e != nil {
+		return "", "", wrapValidationError(CIDR, CodeInvalid, value, e)
+	}
+
 	return cstr, GenerateSHA3256(cstr), nil
 }
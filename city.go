@@ -1,20 +1,236 @@
 package validations
 
 import (
+	"bufio"
+	_ "embed"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode"
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
 )
 
+//go:embed cities.txt
+var citiesData string
+
+// City is a populated place of the gazetteer.
+type City struct {
+	Name      string  `json:"name"`
+	Country   string  `json:"country"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Gazetteer indexes cities by folded name and alias.
+type Gazetteer struct {
+	names   map[string][]City
+	aliases map[string][]City
+}
+
+var (
+	gazetteerMu sync.RWMutex
+	gazetteer   *Gazetteer
+)
+
+func init() {
+	g, err := ParseGazetteer(strings.NewReader(citiesData))
+	if err != nil {
+		panic(err)
+	}
+	gazetteer = g
+}
+
+// ParseGazetteer parses cities in the GeoNames dump format, e.g. the
+// cities15000.txt file. Lines starting with # are ignored.
+func ParseGazetteer(r io.Reader) (*Gazetteer, error) {
+	g := &Gazetteer{names: map[string][]City{}, aliases: map[string][]City{}}
+
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	for n := 1; s.Scan(); n++ {
+		line := s.Text()
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 9 {
+			return nil, fmt.Errorf("invalid gazetteer entry at line %d", n)
+		}
+
+		lat, err := strconv.ParseFloat(fields[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gazetteer latitude at line %d: %w", n, err)
+		}
+		lng, err := strconv.ParseFloat(fields[5], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gazetteer longitude at line %d: %w", n, err)
+		}
+
+		c := City{Name: fields[1], Country: fields[8], Latitude: lat, Longitude: lng}
+		g.add(g.names, c.Name, c)
+		g.add(g.aliases, fields[2], c)
+		for _, alias := range strings.Split(fields[3], ",") {
+			g.add(g.aliases, alias, c)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// add indexes c under name, keeping the first city of every country.
+func (g *Gazetteer) add(index map[string][]City, name string, c City) {
+	key := cityKey(name)
+	if key == "" {
+		return
+	}
+
+	for _, other := range index[key] {
+		if other.Country == c.Country {
+			return
+		}
+	}
+	index[key] = append(index[key], c)
+}
+
+// Lookup finds a city by name or alias, ignoring case and diacritics. An
+// empty country matches any country.
+func (g *Gazetteer) Lookup(name, country string) (City, bool) {
+	key := cityKey(name)
+	for _, index := range []map[string][]City{g.names, g.aliases} {
+		for _, c := range index[key] {
+			if country == "" || c.Country == country {
+				return c, true
+			}
+		}
+	}
+	return City{}, false
+}
+
+// LoadGazetteer replaces the embedded cities subset, which only holds a few
+// hundred major cities, e.g. with the GeoNames cities15000.txt dump. Loading a
+// full dump is required before using WithKnownCities on arbitrary data.
+func LoadGazetteer(r io.Reader) error {
+	g, err := ParseGazetteer(r)
+	if err != nil {
+		return err
+	}
+
+	gazetteerMu.Lock()
+	defer gazetteerMu.Unlock()
+
+	gazetteer = g
+	return nil
+}
+
+// LookupCity finds a city in the current gazetteer. Country is an ISO 3166
+// alpha-2 code or empty.
+func LookupCity(name, country string) (City, bool) {
+	gazetteerMu.RLock()
+	g := gazetteer
+	gazetteerMu.RUnlock()
+
+	return g.Lookup(name, country)
+}
+
+var cityKeyReplacer = strings.NewReplacer(".", " ", "-", " ", "'", "", "’", "", "‘", "")
+
+// cityKey folds a city name: lower case, no diacritics, no punctuation and
+// single spaces.
+func cityKey(name string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(name)) {
+		if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(r)
+		}
+	}
+	return strings.Join(strings.Fields(cityKeyReplacer.Replace(b.String())), " ")
+}
+
+// ValidateCity normalizes a city name to its gazetteer name, or title-cases
+// it when the city is not in the gazetteer.
 func ValidateCity(value interface{}) (string, string, error) {
+	return validateCity(value, Options{})
+}
+
+func validateCity(value interface{}, opts Options) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(CITY, value)
+	}
+
+	v = strings.Join(strings.Fields(v), " ")
+	if v == "" {
+		return "", "", newValidationError(CITY, CodeEmpty, value, "empty city name")
 	}
 
-	v = cases.Title(language.English).String(strings.ToLower(v))
+	if c, ok := LookupCity(v, ""); ok {
+		v = c.Name
+	} else if opts.KnownCities {
+		return "", "", newValidationError(CITY, CodeInvalid, value, "unknown city: %s", v)
+	} else {
+		v = cases.Title(language.English).String(strings.ToLower(v))
+	}
 
 	return v, GenerateSHA3256(v), nil
 }
+
+// cityHook resolves the city attribute in the country attribute of the same
+// entity and, when coordinates is set, fills the latitude and longitude
+// attributes if both are missing.
+func cityHook(coordinates bool) EntityOptionsHook {
+	return func(entity *Entity, opts Options) []error {
+		name, ok := entity.Attributes[city.Type].(string)
+		if !ok {
+			return nil
+		}
+
+		countryName, ok := entity.Attributes[country.Type].(string)
+		if !ok {
+			return nil
+		}
+
+		cc, ok := LookupCountry(countryName)
+		if !ok {
+			return nil
+		}
+
+		c, ok := LookupCity(name, cc.Alpha2)
+		if !ok {
+			if opts.KnownCities {
+				err := newValidationError(CITY, CodeInvalid, name, "unknown city %s in %s", name, cc.Alpha2)
+				err.Type = city.Type
+				return []error{withPath(err, joinPath("", "attributes", city.Type))}
+			}
+			return nil
+		}
+
+		entity.Attributes[city.Type] = c.Name
+		_, hasLat := entity.Attributes[latitude.Type]
+		_, hasLng := entity.Attributes[longitude.Type]
+		if !coordinates || hasLat || hasLng {
+			return nil
+		}
+
+		lat, _, err := ValidateLatitude(c.Latitude)
+		if err != nil {
+			return nil
+		}
+		lng, _, err := ValidateLongitude(c.Longitude)
+		if err != nil {
+			return nil
+		}
+
+		entity.Attributes[latitude.Type] = lat
+		entity.Attributes[longitude.Type] = lng
+		return nil
+	}
+}
@@ -0,0 +1,127 @@
+package validations
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateCity(t *testing.T) {
+	var validCities = map[string]string{
+		"sao paulo":       "São Paulo",
+		"SÃO PAULO":       "São Paulo",
+		"São Paulo":       "São Paulo",
+		"new  york":       "New York City",
+		"Bombay":          "Mumbai",
+		"hobbiton":        "Hobbiton",
+		"little WHINGING": "Little Whinging",
+		"Springfield":     "Springfield",
+		"saint-tropez":    "Saint-Tropez",
+		"Ville-Marie":     "Ville-Marie",
+		"  los angeles  ": "Los Angeles",
+	}
+
+	for in, out := range validCities {
+		v, _, err := ValidateCity(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+		}
+
+		if v != out {
+			t.Errorf("%s: expected %s, got %s", in, out, v)
+		}
+	}
+
+	a, _, _ := ValidateCity("little Whinging")
+	b, _, _ := ValidateCity("Little Whinging")
+	if a != b {
+		t.Errorf("expected the same name, got %s and %s", a, b)
+	}
+
+	for _, in := range []string{"", "   "} {
+		if _, _, err := ValidateCity(in); err == nil {
+			t.Errorf("%q: this should return an error", in)
+		}
+	}
+
+	if _, _, err := ValidateValue("Springfield", "city", WithKnownCities()); err == nil {
+		t.Error("unknown cities should be rejected")
+	}
+}
+
+func TestCityHook(t *testing.T) {
+	e, errs := ValidateEntity(Entity{
+		Type: "cidr",
+		Attributes: map[string]interface{}{
+			"cidr":    "8.8.8.0/24",
+			"city":    "san jose",
+			"country": "USA",
+		},
+	})
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+
+	if e.Attributes["city"] != "San Jose" {
+		t.Errorf("expected San Jose, got %v", e.Attributes["city"])
+	}
+
+	if lat, _ := e.Attributes["latitude"].(float64); lat < 37 || lat > 38 {
+		t.Errorf("unexpected latitude %v", e.Attributes["latitude"])
+	}
+
+	if _, ok := e.Attributes["longitude"].(float64); !ok {
+		t.Errorf("unexpected longitude %v", e.Attributes["longitude"])
+	}
+
+	e, errs = ValidateEntity(Entity{
+		Type: "airport-name",
+		Attributes: map[string]interface{}{
+			"airport-name": "Chhatrapati Shivaji",
+			"city":         "bombay",
+			"country":      "India",
+		},
+	})
+	if len(errs) != 0 || e.Attributes["city"] != "Mumbai" {
+		t.Errorf("expected Mumbai, got %v %v", e.Attributes["city"], errs)
+	}
+
+	_, errs = ValidateEntity(Entity{
+		Type: "airport-name",
+		Attributes: map[string]interface{}{
+			"airport-name": "Charles de Gaulle",
+			"city":         "Paris",
+			"country":      "Germany",
+		},
+	}, WithKnownCities())
+
+	var verr *ValidationError
+	if len(errs) != 1 || !errors.As(errs[0], &verr) || verr.Path != "attributes.city" {
+		t.Errorf("expected an unknown city error, got %v", errs)
+	}
+}
+
+func TestLoadGazetteer(t *testing.T) {
+	defer func() {
+		if err := LoadGazetteer(strings.NewReader(citiesData)); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	dump := "2988507\tParis\tParis\tLutece\t48.85341\t2.3488\tP\tPPLC\tFR\n"
+	if err := LoadGazetteer(strings.NewReader(dump)); err != nil {
+		t.Fatal(err)
+	}
+
+	if c, ok := LookupCity("lutece", "FR"); !ok || c.Name != "Paris" {
+		t.Errorf("unexpected city %+v", c)
+	}
+
+	if _, ok := LookupCity("London", ""); ok {
+		t.Error("London should not be found in the loaded gazetteer")
+	}
+
+	if err := LoadGazetteer(strings.NewReader("Paris\tFR\n")); err == nil {
+		t.Error("invalid dumps should be rejected")
+	}
+}
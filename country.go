@@ -1,20 +1,32 @@
 package validations
 
-import (
-	"fmt"
-	"strings"
+type CountryFormat int
 
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+const (
+	CountryName CountryFormat = iota
+	CountryAlpha2
 )
 
+// ValidateCountry validates an ISO 3166-1 code, name or alias and returns the
+// English short name of the country.
 func ValidateCountry(value interface{}) (string, string, error) {
+	return validateCountry(value, Options{})
+}
+
+func validateCountry(value interface{}, opts Options) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(COUNTRY, value)
 	}
 
-	v = cases.Title(language.English).String(strings.ToLower(v))
+	c, ok := LookupCountry(v)
+	if !ok {
+		return "", "", newValidationError(COUNTRY, CodeInvalid, value, "unknown country: %s", v)
+	}
 
-	return v, GenerateSHA3256(v), nil
+	id := GenerateSHA3256(c.Alpha2)
+	if opts.CountryFormat == CountryAlpha2 {
+		return c.Alpha2, id, nil
+	}
+	return c.Name, id, nil
 }
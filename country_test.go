@@ -0,0 +1,73 @@
+package validations
+
+import "testing"
+
+func TestValidateCountry(t *testing.T) {
+	var validCountries = map[string]string{
+		"US":                                 "United States of America",
+		"usa":                                "United States of America",
+		"840":                                "United States of America",
+		"United States":                      "United States of America",
+		"the united states":                  "United States of America",
+		"U.S.A.":                             "United States of America",
+		"uk":                                 "United Kingdom of Great Britain and Northern Ireland",
+		"Great Britain":                      "United Kingdom of Great Britain and Northern Ireland",
+		"Russia":                             "Russian Federation",
+		"south korea":                        "Korea, Republic of",
+		"Côte d’Ivoire":                      "Côte d'Ivoire",
+		"Ivory Coast":                        "Côte d'Ivoire",
+		"Bosnia & Herzegovina":               "Bosnia and Herzegovina",
+		"Congo (Democratic Republic of the)": "Congo, Democratic Republic of the",
+		"XK":                                 "Kosovo",
+	}
+
+	var invalidCountries = []string{"", "Atlantis", "XX", "999", "Usa Of America"}
+
+	for in, out := range validCountries {
+		v, id, err := ValidateCountry(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+
+		if v != out {
+			t.Errorf("%s: expected %s, got %s", in, out, v)
+		}
+
+		c, _ := LookupCountry(in)
+		if id != GenerateSHA3256(c.Alpha2) {
+			t.Errorf("%s: unexpected ID", in)
+		}
+	}
+
+	for _, in := range invalidCountries {
+		if _, _, err := ValidateCountry(in); err == nil {
+			t.Errorf("%s: this should return an error", in)
+		}
+	}
+
+	v, _, err := ValidateValue("Deutschland", "issuing-country", WithCountryFormat(CountryAlpha2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v != "DE" {
+		t.Errorf("expected DE, got %v", v)
+	}
+}
+
+func TestCountries(t *testing.T) {
+	seen := map[string]bool{}
+	for _, c := range Countries() {
+		for _, code := range []string{c.Alpha2, c.Alpha3, c.Numeric} {
+			if code != "" && seen[code] {
+				t.Errorf("duplicated code %s", code)
+			}
+			seen[code] = true
+		}
+	}
+
+	if len(Countries()) != 250 {
+		t.Errorf("expected 250 countries, got %d", len(Countries()))
+	}
+}
@@ -0,0 +1,421 @@
+package validations
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	cpeAny = "*"
+	cpeNA  = "-"
+
+	cpe23Prefix = "cpe:2.3:"
+	cpe22Prefix = "cpe:/"
+)
+
+var cpeLanguageRegex = regexp.MustCompile(`^[a-z]{2,3}(-([a-z]{2}|[0-9]{3}))?$`)
+
+// CPEName is a Common Platform Enumeration name. Every attribute holds its value
+// as bound in the CPE 2.3 formatted string, "*" meaning ANY and "-" NA.
+type CPEName struct {
+	Part      string `json:"part"`
+	Vendor    string `json:"vendor"`
+	Product   string `json:"product"`
+	Version   string `json:"version"`
+	Update    string `json:"update"`
+	Edition   string `json:"edition"`
+	Language  string `json:"language"`
+	SWEdition string `json:"swEdition"`
+	TargetSW  string `json:"targetSw"`
+	TargetHW  string `json:"targetHw"`
+	Other     string `json:"other"`
+}
+
+func (c CPEName) attributes() []string {
+	return []string{c.Part, c.Vendor, c.Product, c.Version, c.Update, c.Edition, c.Language, c.SWEdition, c.TargetSW, c.TargetHW, c.Other}
+}
+
+func cpeFromAttributes(attrs []string) CPEName {
+	return CPEName{
+		Part:      attrs[0],
+		Vendor:    attrs[1],
+		Product:   attrs[2],
+		Version:   attrs[3],
+		Update:    attrs[4],
+		Edition:   attrs[5],
+		Language:  attrs[6],
+		SWEdition: attrs[7],
+		TargetSW:  attrs[8],
+		TargetHW:  attrs[9],
+		Other:     attrs[10],
+	}
+}
+
+// String returns the CPE 2.3 formatted string binding.
+func (c CPEName) String() string {
+	return cpe23Prefix + strings.Join(c.attributes(), ":")
+}
+
+// ParseCPE parses a CPE 2.3 formatted string or a CPE 2.2 URI.
+func ParseCPE(s string) (CPEName, error) {
+	v := strings.ToLower(strings.TrimSpace(s))
+
+	var attrs []string
+	var err error
+	switch {
+	case strings.HasPrefix(v, cpe23Prefix):
+		attrs, err = parseCPEFormattedString(v[len(cpe23Prefix):])
+	case strings.HasPrefix(v, cpe22Prefix):
+		attrs, err = parseCPEURI(v[len(cpe22Prefix):])
+	default:
+		return CPEName{}, newValidationError(CPE, CodeInvalid, s, "value '%s' is not a CPE name", s)
+	}
+	if err != nil {
+		return CPEName{}, wrapValidationError(CPE, CodeInvalid, s, err)
+	}
+
+	for i, attr := range attrs {
+		attrs[i], err = normalizeCPEAttribute(i, attr)
+		if err != nil {
+			return CPEName{}, wrapValidationError(CPE, CodeInvalid, s, err)
+		}
+	}
+
+	return cpeFromAttributes(attrs), nil
+}
+
+func parseCPEFormattedString(s string) ([]string, error) {
+	var attrs []string
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 == len(s) {
+				return nil, newValidationError(CPE, CodeInvalid, s, "unterminated escape in CPE")
+			}
+			b.WriteByte(s[i])
+			b.WriteByte(s[i+1])
+			i++
+		case ':':
+			attrs = append(attrs, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	attrs = append(attrs, b.String())
+
+	if len(attrs) != 11 {
+		return nil, newValidationError(CPE, CodeInvalid, s, "CPE 2.3 names have 11 components, got %d", len(attrs))
+	}
+
+	return attrs, nil
+}
+
+func parseCPEURI(s string) ([]string, error) {
+	comps := strings.Split(s, ":")
+	if len(comps) > 7 {
+		return nil, newValidationError(CPE, CodeInvalid, s, "CPE 2.2 URIs have at most 7 components, got %d", len(comps))
+	}
+
+	for len(comps) < 7 {
+		comps = append(comps, "")
+	}
+
+	edition := []string{comps[5]}
+	if strings.HasPrefix(comps[5], "~") {
+		edition = strings.Split(comps[5][1:], "~")
+		if len(edition) != 5 {
+			return nil, newValidationError(CPE, CodeInvalid, s, "invalid packed edition: %s", comps[5])
+		}
+	} else {
+		edition = append(edition, "", "", "", "")
+	}
+
+	uri := append(append(comps[:5:5], edition[0], comps[6]), edition[1:]...)
+
+	attrs := make([]string, 0, len(uri))
+	for _, comp := range uri {
+		attr, err := decodeCPEURIComponent(comp)
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, attr)
+	}
+
+	return attrs, nil
+}
+
+// decodeCPEURIComponent converts a CPE 2.2 URI component to its formatted
+// string binding.
+func decodeCPEURIComponent(comp string) (string, error) {
+	switch comp {
+	case "":
+		return cpeAny, nil
+	case cpeNA:
+		return cpeNA, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(comp); i++ {
+		c := comp[i]
+		if c == '%' {
+			if i+2 >= len(comp) {
+				return "", newValidationError(CPE, CodeInvalid, comp, "invalid percent encoding in CPE: %s", comp)
+			}
+			n, err := strconv.ParseUint(comp[i+1:i+3], 16, 8)
+			if err != nil {
+				return "", newValidationError(CPE, CodeInvalid, comp, "invalid percent encoding in CPE: %s", comp)
+			}
+			i += 2
+
+			switch n {
+			case 0x01:
+				b.WriteByte('?')
+				continue
+			case 0x02:
+				b.WriteByte('*')
+				continue
+			}
+			c = byte(n)
+			if isCPEPunctuation(c) {
+				b.WriteByte('\\')
+				b.WriteByte(c)
+				continue
+			}
+		}
+
+		if !isCPEUnreserved(c) {
+			return "", newValidationError(CPE, CodeInvalid, comp, "invalid character '%c' in CPE", c)
+		}
+		b.WriteByte(c)
+	}
+
+	return b.String(), nil
+}
+
+func isCPEUnreserved(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.' || c == '-'
+}
+
+func isCPEPunctuation(c byte) bool {
+	return c > ' ' && c < 0x7f && !isCPEUnreserved(c)
+}
+
+// normalizeCPEAttribute validates the i-th attribute of a formatted string
+// and removes the escapes that are not needed.
+func normalizeCPEAttribute(i int, attr string) (string, error) {
+	if attr == cpeAny || attr == cpeNA {
+		return attr, nil
+	}
+
+	switch i {
+	case 0:
+		if attr != "a" && attr != "o" && attr != "h" {
+			return "", newValidationError(CPE, CodeInvalid, attr, "invalid CPE part: %s", attr)
+		}
+		return attr, nil
+	case 6:
+		if !cpeLanguageRegex.MatchString(attr) {
+			return "", newValidationError(CPE, CodeInvalid, attr, "invalid CPE language: %s", attr)
+		}
+		return attr, nil
+	}
+
+	prefix, body, suffix, err := splitCPEValue(attr)
+	if err != nil {
+		return "", err
+	}
+
+	if body == cpeNA {
+		return prefix + `\-` + suffix, nil
+	}
+
+	var b strings.Builder
+	b.WriteString(prefix)
+	for i := 0; i < len(body); i++ {
+		if isCPEPunctuation(body[i]) {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(body[i])
+	}
+	b.WriteString(suffix)
+	return b.String(), nil
+}
+
+// splitCPEValue splits a formatted string value in its leading wildcards,
+// unescaped body and trailing wildcards.
+func splitCPEValue(attr string) (string, string, string, error) {
+	start := 0
+	if strings.HasPrefix(attr, "*") {
+		start = 1
+	} else {
+		for start < len(attr) && attr[start] == '?' {
+			start++
+		}
+	}
+
+	end := len(attr)
+	if strings.HasSuffix(attr, "*") && !strings.HasSuffix(attr, `\*`) {
+		end--
+	} else {
+		for end > start && attr[end-1] == '?' && (end < 2 || attr[end-2] != '\\') {
+			end--
+		}
+	}
+
+	if start >= end {
+		return "", "", "", newValidationError(CPE, CodeInvalid, attr, "invalid CPE value: %s", attr)
+	}
+
+	var b strings.Builder
+	for i := start; i < end; i++ {
+		c := attr[i]
+		switch {
+		case c == '\\':
+			if i+1 == end || attr[i+1] <= ' ' || attr[i+1] >= 0x7f {
+				return "", "", "", newValidationError(CPE, CodeInvalid, attr, "invalid escape in CPE value: %s", attr)
+			}
+			b.WriteByte(attr[i+1])
+			i++
+		case isCPEUnreserved(c):
+			b.WriteByte(c)
+		default:
+			return "", "", "", newValidationError(CPE, CodeInvalid, attr, "invalid character '%c' in CPE value: %s", c, attr)
+		}
+	}
+
+	return attr[:start], b.String(), attr[end:], nil
+}
+
+type CPERelation string
+
+const (
+	CPEEqual     CPERelation = "equal"
+	CPESuperset  CPERelation = "superset"
+	CPESubset    CPERelation = "subset"
+	CPEDisjoint  CPERelation = "disjoint"
+	CPEUndefined CPERelation = "undefined"
+)
+
+// CompareCPE compares the source name to the target name following the CPE
+// name matching specification. Wildcards are only supported in the source.
+func CompareCPE(source, target CPEName) CPERelation {
+	src := source.attributes()
+	tgt := target.attributes()
+
+	equal, superset, subset := true, true, true
+	for i := range src {
+		switch compareCPEAttribute(src[i], tgt[i]) {
+		case CPEDisjoint:
+			return CPEDisjoint
+		case CPEEqual:
+		case CPESuperset:
+			equal, subset = false, false
+		case CPESubset:
+			equal, superset = false, false
+		default:
+			equal, superset, subset = false, false, false
+		}
+	}
+
+	switch {
+	case equal:
+		return CPEEqual
+	case superset:
+		return CPESuperset
+	case subset:
+		return CPESubset
+	}
+	return CPEUndefined
+}
+
+// Matches reports whether the name matches the target, i.e. whether it is
+// equal to or a superset of it.
+func (c CPEName) Matches(target CPEName) bool {
+	r := CompareCPE(c, target)
+	return r == CPEEqual || r == CPESuperset
+}
+
+func compareCPEAttribute(source, target string) CPERelation {
+	if hasCPEWildcards(target) {
+		return CPEUndefined
+	}
+
+	switch {
+	case source == target:
+		return CPEEqual
+	case source == cpeAny:
+		return CPESuperset
+	case target == cpeAny:
+		return CPESubset
+	case source == cpeNA || target == cpeNA:
+		return CPEDisjoint
+	case !hasCPEWildcards(source):
+		return CPEDisjoint
+	}
+
+	if matchCPEWildcards(source, target) {
+		return CPESuperset
+	}
+	return CPEDisjoint
+}
+
+func hasCPEWildcards(attr string) bool {
+	if attr == cpeAny || attr == cpeNA {
+		return false
+	}
+
+	prefix, _, suffix, err := splitCPEValue(attr)
+	return err == nil && (prefix != "" || suffix != "")
+}
+
+func matchCPEWildcards(source, target string) bool {
+	prefix, body, suffix, err := splitCPEValue(source)
+	if err != nil {
+		return false
+	}
+
+	_, value, _, err := splitCPEValue(target)
+	if err != nil {
+		return false
+	}
+
+	i := strings.Index(value, body)
+	for i >= 0 {
+		if cpeWildcardFits(prefix, i) && cpeWildcardFits(suffix, len(value)-i-len(body)) {
+			return true
+		}
+		next := strings.Index(value[i+1:], body)
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return false
+}
+
+// cpeWildcardFits reports whether a wildcard covers n characters: "*" any
+// number and each "?" exactly one.
+func cpeWildcardFits(wildcard string, n int) bool {
+	if wildcard == "*" {
+		return true
+	}
+	return len(wildcard) == n
+}
+
+func ValidateCPE(value interface{}) (string, string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return "", "", errNotString(CPE, value)
+	}
+
+	c, err := ParseCPE(v)
+	if err != nil {
+		return "", "", err
+	}
+
+	s := c.String()
+	return s, GenerateSHA3256(s), nil
+}
@@ -0,0 +1,91 @@
+package validations
+
+import "testing"
+
+func TestParseCPE(t *testing.T) {
+	var validCPEs = map[string]string{
+		"cpe:2.3:a:microsoft:internet_explorer:8.0.6001:beta:*:*:*:*:*:*":                 "cpe:2.3:a:microsoft:internet_explorer:8.0.6001:beta:*:*:*:*:*:*",
+		"CPE:2.3:O:Linux:Linux_Kernel:5.10:*:*:*:*:*:*:*":                                 "cpe:2.3:o:linux:linux_kernel:5.10:*:*:*:*:*:*:*",
+		"cpe:2.3:a:hp:insight_diagnostics:7.4.0.1570:-:*:*:online:win2003:x64:*":          "cpe:2.3:a:hp:insight_diagnostics:7.4.0.1570:-:*:*:online:win2003:x64:*",
+		"cpe:2.3:a:foo\\\\bar:big\\$money_manager_2010:*:*:*:*:special:ipod_touch:80gb:*": "cpe:2.3:a:foo\\\\bar:big\\$money_manager_2010:*:*:*:*:special:ipod_touch:80gb:*",
+		"cpe:2.3:a:apache:http_server:2.4.*:*:*:en-us:*:*:*:*":                            "cpe:2.3:a:apache:http_server:2.4.*:*:*:en-us:*:*:*:*",
+		"cpe:2.3:a:vendor:prod\\.uct:1\\.0:*:*:*:*:*:*:*":                                 "cpe:2.3:a:vendor:prod.uct:1.0:*:*:*:*:*:*:*",
+		"cpe:/a:microsoft:internet_explorer:8.0.6001:beta":                                "cpe:2.3:a:microsoft:internet_explorer:8.0.6001:beta:*:*:*:*:*:*",
+		"cpe:/o:microsoft:windows_xp::sp2:pro":                                            "cpe:2.3:o:microsoft:windows_xp:*:sp2:pro:*:*:*:*:*",
+		"cpe:/a:hp:insight_diagnostics:7.4.0.1570::~~online~win2003~x64~":                 "cpe:2.3:a:hp:insight_diagnostics:7.4.0.1570:*:*:*:online:win2003:x64:*",
+		"cpe:/a:foo%5cbar:big%24money_2010":                                               "cpe:2.3:a:foo\\\\bar:big\\$money_2010:*:*:*:*:*:*:*:*",
+		"cpe:/a:vendor:product:1.%02:-::en-us":                                            "cpe:2.3:a:vendor:product:1.*:-:*:en-us:*:*:*:*",
+	}
+
+	var invalidCPEs = []string{
+		"cpe:2.3:x:microsoft:internet_explorer:8.0:*:*:*:*:*:*:*",
+		"cpe:2.3:a:microsoft:internet_explorer:8.0:*:*:*:*:*:*",
+		"cpe:2.3:a:micro$oft:internet_explorer:8.0:*:*:*:*:*:*:*",
+		"cpe:2.3:a:microsoft:internet*explorer:8.0:*:*:*:*:*:*:*",
+		"cpe:2.3:a:microsoft:ie:8.0:*:*:english:*:*:*:*",
+		"cpe:/a:microsoft:ie:8.0:*:*:*:*",
+		"cpe:/a:hp:insight:1.0::~~online~win2003",
+		"cpe:/a:hp:insight:1~0",
+		"microsoft internet explorer",
+	}
+
+	for in, out := range validCPEs {
+		v, _, err := ValidateCPE(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+
+		if v != out {
+			t.Errorf("expected %s, got %s", out, v)
+		}
+	}
+
+	for _, in := range invalidCPEs {
+		if _, _, err := ValidateCPE(in); err == nil {
+			t.Errorf("%s: this should return an error", in)
+		}
+	}
+
+	c, err := ParseCPE("cpe:/a:microsoft:internet_explorer:8.0.6001:beta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Part != "a" || c.Vendor != "microsoft" || c.Product != "internet_explorer" || c.Version != "8.0.6001" {
+		t.Errorf("unexpected CPE %+v", c)
+	}
+}
+
+func TestCompareCPE(t *testing.T) {
+	var cases = []struct {
+		source, target string
+		relation       CPERelation
+	}{
+		{"cpe:2.3:a:microsoft:internet_explorer:8.0.6001:beta:*:*:*:*:*:*", "cpe:2.3:a:microsoft:internet_explorer:8.0.6001:beta:*:*:*:*:*:*", CPEEqual},
+		{"cpe:2.3:a:microsoft:internet_explorer:*:*:*:*:*:*:*:*", "cpe:2.3:a:microsoft:internet_explorer:8.0.6001:beta:*:*:*:*:*:*", CPESuperset},
+		{"cpe:2.3:a:microsoft:internet_explorer:8.0.6001:beta:*:*:*:*:*:*", "cpe:2.3:a:microsoft:internet_explorer:*:*:*:*:*:*:*:*", CPESubset},
+		{"cpe:2.3:a:microsoft:internet_explorer:8.*:*:*:*:*:*:*:*", "cpe:2.3:a:microsoft:internet_explorer:8.0.6001:beta:*:*:*:*:*:*", CPESuperset},
+		{"cpe:2.3:a:microsoft:internet_explorer:8.?:*:*:*:*:*:*:*", "cpe:2.3:a:microsoft:internet_explorer:8.0.6001:*:*:*:*:*:*:*", CPEDisjoint},
+		{"cpe:2.3:a:microsoft:internet_explorer:8.?:*:*:*:*:*:*:*", "cpe:2.3:a:microsoft:internet_explorer:8.0:*:*:*:*:*:*:*", CPESuperset},
+		{"cpe:2.3:a:microsoft:internet_explorer:8.0:-:*:*:*:*:*:*", "cpe:2.3:a:microsoft:internet_explorer:8.0:beta:*:*:*:*:*:*", CPEDisjoint},
+		{"cpe:2.3:a:microsoft:internet_explorer:*:beta:*:*:*:*:*:*", "cpe:2.3:a:microsoft:internet_explorer:8.0:*:*:*:*:*:*:*", CPEUndefined},
+		{"cpe:2.3:a:microsoft:internet_explorer:8.0:*:*:*:*:*:*:*", "cpe:2.3:a:microsoft:internet_explorer:8.*:*:*:*:*:*:*:*", CPEUndefined},
+	}
+
+	for _, c := range cases {
+		source, err := ParseCPE(c.source)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		target, err := ParseCPE(c.target)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r := CompareCPE(source, target); r != c.relation {
+			t.Errorf("%s %s: expected %s, got %s", c.source, c.target, c.relation, r)
+		}
+	}
+}
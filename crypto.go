@@ -0,0 +1,15 @@
+package validations
+
+const (
+	Mainnet  = "mainnet"
+	Testnet  = "testnet"
+	Stagenet = "stagenet"
+	Regtest  = "regtest"
+)
+
+// CryptoAddress is a parsed cryptocurrency address.
+type CryptoAddress struct {
+	Address string `json:"address"`
+	Network string `json:"network"`
+	Kind    string `json:"kind"`
+}
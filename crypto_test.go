@@ -0,0 +1,64 @@
+package validations
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCryptoAddresses(t *testing.T) {
+	var validAddresses = []struct {
+		parse   func(string) (CryptoAddress, error)
+		address string
+		network string
+		kind    string
+	}{
+		{ParseBTCAddress, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Mainnet, "p2pkh"},
+		{ParseBTCAddress, "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", Mainnet, "p2sh"},
+		{ParseBTCAddress, "mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn", Testnet, "p2pkh"},
+		{ParseBTCAddress, "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", Mainnet, "p2wpkh"},
+		{ParseBTCAddress, "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", Testnet, "p2wsh"},
+		{ParseBTCAddress, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", Mainnet, "p2tr"},
+		{ParseXMRAddress, "44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A", Mainnet, "standard"},
+		{ParseXMRAddress, "888tNkZrPN6JsEgekjMnABU4TBzc2Dt29EPAvkRxbANsAnjyPbb3iQ1YBRk1UXcdRsiKc9dhwMVgN5S9cQUiyoogDavup3H", Mainnet, "subaddress"},
+		{ParseXMRAddress, "4LL9oSLmtpccfufTMvppY6JwXNouMBzSkbLYfpAV5Usx3skxNgYeYTRj5UzqtReoS44qo9mtmXCqY45DJ852K5Jv2bYXZKKQePHES9khPK", Mainnet, "integrated"},
+		{ParseDashAddress, "XpESxaUmonkq8RaLLp46Brx2K39ggQe226", Mainnet, "p2pkh"},
+		{ParseDashAddress, "7gnwGHt17heGpG9Crfeh4KGpYNFugPhJdh", Mainnet, "p2sh"},
+		{ParseDashAddress, "yNPbcFfabtNmmxKdGwhHomdYfVs6gikbPf", Testnet, "p2pkh"},
+	}
+
+	for _, c := range validAddresses {
+		addr, err := c.parse(c.address)
+		if err != nil {
+			t.Errorf("%s: %v", c.address, err)
+			continue
+		}
+
+		if addr.Network != c.network || addr.Kind != c.kind {
+			t.Errorf("%s: unexpected address %+v", c.address, addr)
+		}
+	}
+
+	var invalidAddresses = []struct {
+		t       string
+		address string
+		code    string
+	}{
+		{"btc", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3", CodeBadChecksum},
+		{"btc", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5", CodeBadChecksum},
+		{"btc", "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd", CodeBadChecksum},
+		{"btc", "XpESxaUmonkq8RaLLp46Brx2K39ggQe226", CodeInvalid},
+		{"btc", "not an address", CodeInvalid},
+		{"xmr", "44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3B", CodeBadChecksum},
+		{"xmr", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", CodeInvalid},
+		{"dash", "XpESxaUmonkq8RaLLp46Brx2K39ggQe227", CodeBadChecksum},
+		{"dash", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", CodeInvalid},
+	}
+
+	for _, c := range invalidAddresses {
+		_, _, err := ValidateValue(c.address, c.t)
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Code != c.code {
+			t.Errorf("%s: expected %s, got %v", c.address, c.code, err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package validations
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var cveRegex = regexp.MustCompile(`^CVE-([0-9]{4})-([0-9]{4,19})$`)
+
+// CVEID is a parsed CVE identifier such as CVE-2021-44228.
+type CVEID struct {
+	Year     int   `json:"year"`
+	Sequence int64 `json:"sequence"`
+}
+
+func (c CVEID) String() string {
+	return fmt.Sprintf("CVE-%04d-%04d", c.Year, c.Sequence)
+}
+
+// ParseCVE parses a CVE identifier. The sequence number needs at least four
+// digits and only four digit sequences may be zero padded.
+func ParseCVE(s string) (CVEID, error) {
+	v := strings.ToUpper(strings.TrimSpace(s))
+
+	m := cveRegex.FindStringSubmatch(v)
+	if m == nil {
+		return CVEID{}, newValidationError(CVE, CodeRegexMismatch, s, "value '%s' is not a CVE identifier", s)
+	}
+
+	if len(m[2]) > 4 && m[2][0] == '0' {
+		return CVEID{}, newValidationError(CVE, CodeInvalid, s, "invalid CVE sequence number: %s", m[2])
+	}
+
+	year, _ := strconv.Atoi(m[1])
+	if year < 1999 || year > time.Now().UTC().Year()+1 {
+		return CVEID{}, newValidationError(CVE, CodeInvalid, s, "invalid CVE year: %d", year)
+	}
+
+	seq, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return CVEID{}, wrapValidationError(CVE, CodeInvalid, s, err)
+	}
+
+	return CVEID{Year: year, Sequence: seq}, nil
+}
+
+func ValidateCVE(value interface{}) (string, string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return "", "", errNotString(CVE, value)
+	}
+
+	id, err := ParseCVE(v)
+	if err != nil {
+		return "", "", err
+	}
+
+	c := id.String()
+	return c, GenerateSHA3256(c), nil
+}
@@ -0,0 +1,47 @@
+package validations
+
+import "testing"
+
+func TestValidateCVE(t *testing.T) {
+	var validCVEs = map[string]string{
+		"CVE-2021-44228":  "CVE-2021-44228",
+		"cve-2014-0160":   "CVE-2014-0160",
+		" CVE-1999-0001 ": "CVE-1999-0001",
+		"CVE-2023-123456": "CVE-2023-123456",
+	}
+
+	var invalidCVEs = []string{
+		"cve-foo",
+		"CVE-2021-123",
+		"CVE-1998-0001",
+		"CVE-9999-0001",
+		"CVE-2021-012345",
+		"CVE-2021-44228x",
+	}
+
+	for in, out := range validCVEs {
+		v, _, err := ValidateCVE(in)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if v != out {
+			t.Errorf("expected %s, got %s", out, v)
+		}
+	}
+
+	for _, in := range invalidCVEs {
+		if _, _, err := ValidateCVE(in); err == nil {
+			t.Errorf("%s: this should return an error", in)
+		}
+	}
+
+	id, err := ParseCVE("CVE-2021-44228")
+	if err != nil || id.Year != 2021 || id.Sequence != 44228 {
+		t.Errorf("unexpected CVE %+v: %v", id, err)
+	}
+
+	if _, _, err := ValidateValue("cve-foo", "cve"); err == nil {
+		t.Error("this should return an error")
+	}
+}
@@ -0,0 +1,38 @@
+package validations
+
+var dashVersions = map[byte]CryptoAddress{
+	0x4c: {Network: Mainnet, Kind: "p2pkh"},
+	0x10: {Network: Mainnet, Kind: "p2sh"},
+	0x8c: {Network: Testnet, Kind: "p2pkh"},
+	0x13: {Network: Testnet, Kind: "p2sh"},
+}
+
+// ParseDashAddress parses a Base58Check Dash address.
+func ParseDashAddress(s string) (CryptoAddress, error) {
+	payload, err := base58CheckDecode(DASH, s)
+	if err != nil {
+		return CryptoAddress{}, err
+	}
+
+	addr, ok := dashVersions[payload[0]]
+	if !ok || len(payload) != 21 {
+		return CryptoAddress{}, newValidationError(DASH, CodeInvalid, s, "invalid dash address: %s", s)
+	}
+
+	addr.Address = s
+	return addr, nil
+}
+
+func ValidateDash(value interface{}) (string, string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return "", "", errNotString(DASH, value)
+	}
+
+	addr, err := ParseDashAddress(v)
+	if err != nil {
+		return "", "", err
+	}
+
+	return addr.Address, GenerateSHA3256(addr.Address), nil
+}
@@ -1,18 +1,75 @@
 package validations
 
 import (
-	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// Pseudo layouts for numeric timestamps. Numbers are matched by magnitude so
+// the three can be listed together: Unix seconds below 1e11, Unix
+// milliseconds below 1e14 and FILETIME intervals from 1e16.
+const (
+	UnixSeconds = "unix"
+	UnixMillis  = "unixmilli"
+	FILETIME    = "filetime"
+)
+
+// DefaultDatetimeLayouts are the layouts tried by ValidateDatetime when no
+// layout is configured.
+var DefaultDatetimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC1123Z,
+	time.RFC1123,
+	"20060102T150405.999999999Z0700",
+	"20060102T150405Z0700",
+	UnixSeconds,
+	UnixMillis,
+	FILETIME,
+}
+
+// filetimeEpoch is the number of 100 ns intervals between 1601-01-01 and
+// the Unix epoch.
+const filetimeEpoch = 116444736000000000
+
+// TimeBounds restricts the accepted dates and datetimes. The zero value
+// accepts any time.
+type TimeBounds struct {
+	NotBefore   time.Time
+	NotInFuture bool
+}
+
+// pastTimeBounds accepts times between the Unix epoch and now.
+var pastTimeBounds = WithTimeBounds(TimeBounds{NotBefore: time.Unix(0, 0).UTC(), NotInFuture: true})
+
+func (b TimeBounds) check(dataType string, t time.Time, value interface{}) error {
+	if !b.NotBefore.IsZero() && t.Before(b.NotBefore) {
+		return newValidationError(dataType, CodeOutOfRange, value, "time before %s: %v", b.NotBefore.Format(time.RFC3339), value)
+	}
+
+	if b.NotInFuture && t.After(time.Now()) {
+		return newValidationError(dataType, CodeOutOfRange, value, "time in the future: %v", value)
+	}
+	return nil
+}
+
 func ValidateDate(value interface{}) (string, string, error) {
+	return validateDate(value, Options{})
+}
+
+func validateDate(value interface{}, opts Options) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(DATE, value)
 	}
 
 	tmp, err := time.Parse("2006-01-02", v)
 	if err != nil {
+		return "", "", wrapValidationError(DATE, CodeInvalid, value, err)
+	}
+
+	if err := opts.TimeBounds.check(DATE, tmp, value); err != nil {
 		return "", "", err
 	}
 
@@ -20,17 +77,128 @@ func ValidateDate(value interface{}) (string, string, error) {
 	return ftime, GenerateSHA3256(ftime), nil
 }
 
+// ParseDatetime parses value, a string or a number, with the first matching
+// layout. The layouts default to DefaultDatetimeLayouts. Calendar layouts are
+// tried before the numeric pseudo layouts, and digit strings are only taken
+// as timestamps from minEpochDigits digits.
+func ParseDatetime(value interface{}, layouts ...string) (time.Time, bool) {
+	if len(layouts) == 0 {
+		layouts = DefaultDatetimeLayouts
+	}
+
+	var s string
+	var n int64
+	numeric := false
+	switch v := value.(type) {
+	case string:
+		s = strings.TrimSpace(v)
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil && len(strings.TrimPrefix(s, "-")) >= minEpochDigits {
+			n, numeric = i, true
+		}
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<63 {
+			n, numeric = int64(v), true
+		}
+	case int64:
+		n, numeric = v, true
+	case int:
+		n, numeric = int64(v), true
+	default:
+		return time.Time{}, false
+	}
+
+	if s != "" {
+		for _, layout := range layouts {
+			switch layout {
+			case UnixSeconds, UnixMillis, FILETIME:
+				continue
+			}
+
+			if t, err := time.Parse(layout, s); err == nil {
+				if t, ok := zoneOffset(layout, t); ok {
+					return t, true
+				}
+			}
+		}
+	}
+
+	if !numeric {
+		return time.Time{}, false
+	}
+
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+
+	for _, layout := range layouts {
+		switch layout {
+		case UnixSeconds:
+			if abs < 1e11 {
+				return time.Unix(n, 0), true
+			}
+		case UnixMillis:
+			if abs >= 1e11 && abs < 1e14 {
+				return time.UnixMilli(n), true
+			}
+		case FILETIME:
+			if n >= 1e16 {
+				intervals := n - filetimeEpoch
+				return time.Unix(intervals/1e7, intervals%1e7*100), true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// minEpochDigits is the number of digits from which strings are parsed as
+// timestamps, so that e.g. 20231114 is not taken for 1970-08-23.
+const minEpochDigits = 9
+
+// rfc822Zones are the zone abbreviations of RFC 5322 with their offset in
+// hours. time.Parse gives other abbreviations a zero offset.
+var rfc822Zones = map[string]int{
+	"UT": 0, "UTC": 0, "GMT": 0,
+	"EST": -5, "EDT": -4,
+	"CST": -6, "CDT": -5,
+	"MST": -7, "MDT": -6,
+	"PST": -8, "PDT": -7,
+}
+
+// zoneOffset applies the offset of the zone abbreviation of a time parsed
+// with layout, rejecting the abbreviations that are not known.
+func zoneOffset(layout string, t time.Time) (time.Time, bool) {
+	if !strings.Contains(layout, "MST") {
+		return t, true
+	}
+
+	name, _ := t.Zone()
+	hours, ok := rfc822Zones[name]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	zone := time.FixedZone(name, hours*3600)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), zone), true
+}
+
+// ValidateDatetime parses a datetime with the default layouts and returns it
+// in UTC, so the same instant always has the same ID.
 func ValidateDatetime(value interface{}) (string, string, error) {
-	v, ok := value.(string)
+	return validateDatetime(value, Options{})
+}
+
+func validateDatetime(value interface{}, opts Options) (string, string, error) {
+	t, ok := ParseDatetime(value, opts.DatetimeLayouts...)
 	if !ok {
-		return "", "", fmt.Errorf("value not string: %v", value)
+		return "", "", newValidationError(DATETIME, CodeInvalid, value, "invalid datetime: %v", value)
 	}
 
-	tmp, err := time.Parse(time.RFC3339Nano, v)
-	if err != nil {
+	if err := opts.TimeBounds.check(DATETIME, t, value); err != nil {
 		return "", "", err
 	}
 
-	ftime := tmp.Format(time.RFC3339Nano)
+	ftime := t.UTC().Format(time.RFC3339Nano)
 	return ftime, GenerateSHA3256(ftime), nil
 }
@@ -0,0 +1,97 @@
+package validations
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateDatetime(t *testing.T) {
+	const utc = "2023-11-14T22:13:20Z"
+
+	var validDatetimes = map[interface{}]string{
+		"2023-11-14T22:13:20Z":            utc,
+		"2023-11-15T00:13:20+02:00":       utc,
+		"Tue, 14 Nov 2023 17:13:20 -0500": utc,
+		"Tue, 14 Nov 2023 22:13:20 UTC":   utc,
+		"Tue, 14 Nov 2023 22:13:20 GMT":   utc,
+		"Tue, 14 Nov 2023 17:13:20 EST":   utc,
+		"20231114T221320Z":                utc,
+		"20231115T001320+0200":            utc,
+		"1700000000":                      utc,
+		float64(1700000000):               utc,
+		int64(1700000000000):              utc,
+		"133444736000000000":              utc,
+		"133444736000000009":              "2023-11-14T22:13:20.0000009Z",
+		"133444736000000001":              "2023-11-14T22:13:20.0000001Z",
+		"2023-11-14T22:13:20.5Z":          "2023-11-14T22:13:20.5Z",
+	}
+
+	var invalidDatetimes = []interface{}{"", "2023-11-14", "14/11/2023 22:13", "yesterday", 1.5, true, "20231114", "Tue, 14 Nov 2023 22:13:20 CET"}
+
+	for in, out := range validDatetimes {
+		v, id, err := ValidateDatetime(in)
+		if err != nil {
+			t.Errorf("%v: %v", in, err)
+			continue
+		}
+
+		if v != out || id != GenerateSHA3256(out) {
+			t.Errorf("%v: expected %s, got %s", in, out, v)
+		}
+	}
+
+	for _, in := range invalidDatetimes {
+		if _, _, err := ValidateDatetime(in); err == nil {
+			t.Errorf("%v: this should return an error", in)
+		}
+	}
+
+	v, _, err := ValidateValue("14/11/2023 22:13", "datetime", WithDatetimeLayouts("02/01/2006 15:04"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v != "2023-11-14T22:13:00Z" {
+		t.Errorf("unexpected datetime %v", v)
+	}
+
+	v, _, err = ValidateValue("20231114", "datetime", WithDatetimeLayouts(UnixSeconds, "20060102"))
+	if err != nil || v != "2023-11-14T00:00:00Z" {
+		t.Errorf("calendar layouts should be tried before epochs, got %v %v", v, err)
+	}
+
+	if _, _, err := ValidateValue("1700000000", "datetime", WithDatetimeLayouts(time.RFC3339)); err == nil {
+		t.Error("epochs should be rejected when not in the layouts")
+	}
+}
+
+func TestTimeBounds(t *testing.T) {
+	future := time.Now().Add(48 * time.Hour)
+
+	var cases = []struct {
+		value interface{}
+		t     string
+	}{
+		{future.Format(time.RFC3339), "last-analysis"},
+		{"1969-12-31T23:59:59Z", "last-analysis"},
+		{future.Format("2006-01-02"), "breach-date"},
+		{"1969-07-20", "breach-date"},
+	}
+
+	for _, c := range cases {
+		_, _, err := ValidateValue(c.value, c.t)
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Code != CodeOutOfRange {
+			t.Errorf("%s %v: expected an out of range error, got %v", c.t, c.value, err)
+		}
+
+		if _, _, err := ValidateValue(c.value, c.t, WithTimeBounds(TimeBounds{})); err != nil {
+			t.Errorf("%s %v: call options should override the bounds: %v", c.t, c.value, err)
+		}
+	}
+
+	if _, _, err := ValidateValue(future.Format(time.RFC3339), "datetime"); err != nil {
+		t.Error(err)
+	}
+}
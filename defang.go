@@ -0,0 +1,103 @@
+package validations
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	refangDotRegex    = regexp.MustCompile(`(?i)\[\.\]|\(\.\)|\{\.\}|\[dot\]|\(dot\)|\{dot\}|\\\.`)
+	refangSchemeRegex = regexp.MustCompile(`(?i)\bh(?:xx|\*\*)p(s?):`)
+	refangColonRegex  = regexp.MustCompile(`\[:\]|\[://\]`)
+	refangAtRegex     = regexp.MustCompile(`(?i)\[@\]|\(@\)|\{@\}|\[at\]|\(at\)`)
+)
+
+// Refang reverts the common conventions used to defang IOCs, e.g.
+// hxxp://evil[.]com becomes http://evil.com.
+func Refang(s string) string {
+	// Colons are refanged first so that hxxp[://] is seen as a scheme.
+	s = refangColonRegex.ReplaceAllStringFunc(s, func(m string) string {
+		return strings.Trim(m, "[]")
+	})
+	s = refangSchemeRegex.ReplaceAllString(s, "http$1:")
+	s = refangDotRegex.ReplaceAllString(s, ".")
+	return refangAtRegex.ReplaceAllString(s, "@")
+}
+
+// refangable reports whether values of a data type are refanged with the
+// Refang option. Other data types, e.g. paths and free text, may contain
+// the defang conventions legitimately.
+func refangable(dataType string) bool {
+	switch dataType {
+	case URL, IP, CIDR, FQDN, DOMAIN, EMAIL:
+		return true
+	}
+	return false
+}
+
+// Defang renders a normalized value of the given data type so that it can
+// not be followed or resolved when shared in a report. Values of other data
+// types are returned unchanged.
+func Defang(value string, dataType string) string {
+	switch dataType {
+	case URL:
+		return defangURL(value)
+	case FQDN, DOMAIN:
+		return defangDots(value)
+	case EMAIL:
+		local, host, ok := strings.Cut(value, "@")
+		if !ok {
+			return value
+		}
+		return local + "[@]" + defangDots(host)
+	case IP, CIDR:
+		if strings.Contains(value, ":") {
+			return strings.ReplaceAll(value, ":", "[:]")
+		}
+		if i := strings.LastIndex(value, "."); i >= 0 {
+			return value[:i] + "[.]" + value[i+1:]
+		}
+	}
+	return value
+}
+
+func defangDots(s string) string {
+	return strings.ReplaceAll(s, ".", "[.]")
+}
+
+func defangURL(s string) string {
+	scheme, rest, ok := strings.Cut(s, "://")
+	if !ok {
+		return s
+	}
+
+	switch strings.ToLower(scheme) {
+	case "http":
+		scheme = "hxxp"
+	case "https":
+		scheme = "hxxps"
+	}
+
+	end := strings.IndexAny(rest, "/?#")
+	if end < 0 {
+		end = len(rest)
+	}
+	return scheme + "[:]//" + defangDots(rest[:end]) + rest[end:]
+}
+
+// DefangValue validates value against the definition of type t and returns
+// its defanged normalized form.
+func (r *Registry) DefangValue(value interface{}, t string, opts ...Option) (string, error) {
+	def, ok := r.Definition(t)
+	if !ok {
+		return "", &ValidationError{Type: t, Code: CodeUnknownType, Value: value, Message: fmt.Sprintf("unknown type: %s", t)}
+	}
+
+	nv, _, err := r.validateDefinition(value, def, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return Defang(fmt.Sprint(nv), def.DataType), nil
+}
@@ -0,0 +1,101 @@
+package validations
+
+import "testing"
+
+func TestRefang(t *testing.T) {
+	var cases = map[string]string{
+		"hxxp://evil[.]com/path":      "http://evil.com/path",
+		"hXXps[:]//evil(.)com":        "https://evil.com",
+		"1.2.3[.]4":                   "1.2.3.4",
+		"evil{.}example[dot]com":      "evil.example.com",
+		"user[@]evil[.]com":           "user@evil.com",
+		"user[at]evil(dot)com":        "user@evil.com",
+		"http[://]evil.com":           "http://evil.com",
+		"hxxp[://]evil[.]com":         "http://evil.com",
+		"hxxps[://]evil[.]com/x":      "https://evil.com/x",
+		"2001[:]db8[:][:]1":           "2001:db8::1",
+		"http://already.example.com/": "http://already.example.com/",
+	}
+
+	for in, out := range cases {
+		if v := Refang(in); v != out {
+			t.Errorf("%s: expected %s, got %s", in, out, v)
+		}
+	}
+}
+
+func TestDefang(t *testing.T) {
+	var cases = []struct {
+		value    string
+		dataType string
+		out      string
+	}{
+		{"http://evil.com/a.php?x=1.2", URL, "hxxp[:]//evil[.]com/a.php?x=1.2"},
+		{"https://evil.com", URL, "hxxps[:]//evil[.]com"},
+		{"evil.example.com", FQDN, "evil[.]example[.]com"},
+		{"user@evil.com", EMAIL, "user[@]evil[.]com"},
+		{"1.2.3.4", IP, "1.2.3[.]4"},
+		{"2001:db8::1", IP, "2001[:]db8[:][:]1"},
+		{"10.0.0.0/8", CIDR, "10.0.0[.]0/8"},
+		{"hello.world", STR, "hello.world"},
+	}
+
+	for _, c := range cases {
+		if v := Defang(c.value, c.dataType); v != c.out {
+			t.Errorf("%s: expected %s, got %s", c.value, c.out, v)
+		}
+
+		if v := Refang(c.out); v != c.value {
+			t.Errorf("%s: round trip returned %s", c.value, v)
+		}
+	}
+}
+
+func TestValidateWithRefang(t *testing.T) {
+	var cases = map[string]string{
+		"url":      "hxxp://evil[.]com/x",
+		"ip":       "1.2.3[.]4",
+		"hostname": "www.evil[.]com",
+	}
+
+	for typ, in := range cases {
+		if _, _, err := ValidateValue(in, typ); err == nil {
+			t.Errorf("%s: defanged value should not validate without refang", in)
+		}
+
+		v, _, err := ValidateValue(in, typ, WithRefang())
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+
+		d, err := DefangValue(v, typ)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if Refang(d) != v {
+			t.Errorf("%s: defanged value %s does not refang to %v", in, d, v)
+		}
+	}
+}
+
+func TestRefangDataTypes(t *testing.T) {
+	var cases = map[string]string{
+		"path":  `C:\Users\.ssh\id_rsa`,
+		"value": "contact me [at] example (dot) com",
+	}
+
+	for typ, in := range cases {
+		want, _, err := ValidateValue(in, typ)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+
+		v, _, err := ValidateValue(in, typ, WithRefang())
+		if err != nil || v != want {
+			t.Errorf("%s: expected %v unchanged, got %v %v", in, want, v, err)
+		}
+	}
+}
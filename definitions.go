@@ -36,6 +36,21 @@ const (
 	PATH        = "Path"
 	OBJECT      = "UUID|MD5|SHA3-256"
 	ADVERSARY   = "Adversary"
+	CVE         = "CVE"
+	CPE         = "CPE"
+	BTC         = "Bitcoin address"
+	XMR         = "Monero address"
+	DASH        = "Dash address"
+	IBAN        = "IBAN"
+	BIC         = "BIC"
+	CARD        = "Card number"
+	ABA         = "ABA routing number"
+	LATITUDE    = "Latitude"
+	LONGITUDE   = "Longitude"
+	DOMAIN      = "Domain"
+	SSDEEP      = "ssdeep"
+	TLSH        = "TLSH"
+	HASH        = "Hash"
 )
 
 type Definition struct {
@@ -47,13 +62,14 @@ type Definition struct {
 	Associations []Definition `json:"associations,omitempty"`
 	Tags         []string     `json:"tags,omitempty"`
 	Correlate    []string     `json:"correlate,omitempty"`
+	Options      []Option     `json:"-"`
 }
 
 var file = Definition{
 	Type:         "file",
 	Description:  "Object identifying a file, the value can be a UUID or a SHA3-256 or MD5 checksum",
 	DataType:     OBJECT,
-	Attributes:   []Definition{fileData, hashSHA1, hashMD5, hashSHA256, hashSHA3256},
+	Attributes:   []Definition{fileData, hashSHA1, hashMD5, hashSHA256, hashSHA3256, hashSHA224, hashSHA384, hashSHA512, hashSHA3224, hashSHA3384, hashSHA3512, hashSHA512224, hashSHA512256, hashImphash, hashSSDEEP, hashTLSH, hashAny},
 	Associations: []Definition{filename, filenamePattern},
 	Tags:         []string{"malware", "common-file", "system-file"},
 	Correlate:    []string{"md5", "sha1", "sha256", "sha3-256", "file-data"},
@@ -122,19 +138,19 @@ var descriptor = Definition{
 var abaRtn = Definition{
 	Type:        "aba-rtn",
 	Description: "ABA routing transit number",
-	DataType:    INTEGER,
+	DataType:    ABA,
 }
 
 var latitude = Definition{
 	Type:        "latitude",
 	Description: "GPS latitude",
-	DataType:    FLOAT,
+	DataType:    LATITUDE,
 }
 
 var longitude = Definition{
 	Type:        "longitude",
 	Description: "GPS longitude",
-	DataType:    FLOAT,
+	DataType:    LONGITUDE,
 }
 
 var country = Definition{
@@ -191,13 +207,13 @@ var bankAccountNr = Definition{
 	Type:        "bank-account-nr",
 	Description: "Bank account number without any routing number",
 	DataType:    INTEGER,
-	Attributes:  []Definition{bic, bin},
+	Attributes:  []Definition{bic, bin, iban},
 }
 
 var bic = Definition{
 	Type:        "bic",
 	Description: "Bank Identifier Code Number also known as SWIFT-BIC, SWIFT code or ISO 9362 code",
-	DataType:    ISTR,
+	DataType:    BIC,
 }
 
 var bin = Definition{
@@ -209,13 +225,13 @@ var bin = Definition{
 var btc = Definition{
 	Type:        "btc",
 	Description: "Bitcoin Address",
-	DataType:    STR,
+	DataType:    BTC,
 }
 
 var ccNumber = Definition{
 	Type:        "cc-number",
 	Description: "Credit-Card Number",
-	DataType:    INTEGER,
+	DataType:    CARD,
 	Attributes:  []Definition{issuer},
 }
 
@@ -253,19 +269,19 @@ var subnet = Definition{
 var cpe = Definition{
 	Type:        "cpe",
 	Description: "Common Platform Enumeration. Structured naming scheme for information technology systems, software, and packages",
-	DataType:    ISTR,
+	DataType:    CPE,
 }
 
 var cve = Definition{
 	Type:        "cve",
-	Description: "",
-	DataType:    ISTR,
+	Description: "Common Vulnerabilities and Exposures identifier in the format CVE-YYYY-NNNN",
+	DataType:    CVE,
 }
 
 var dash = Definition{
 	Type:        "dash",
 	Description: "Dash address",
-	DataType:    STR,
+	DataType:    DASH,
 }
 
 var dkim = Definition{
@@ -283,7 +299,7 @@ var dkimSignature = Definition{
 var domain = Definition{
 	Type:        "domain",
 	Description: "Internet domain",
-	DataType:    FQDN,
+	DataType:    DOMAIN,
 	Attributes:  []Definition{whoIsRegistrant, whoIsRegistrar},
 }
 
@@ -377,6 +393,7 @@ var filename = Definition{
 	Type:        "filename",
 	Description: "A filename or email attachment name",
 	DataType:    ISTR,
+	Attributes:  []Definition{mimeType},
 }
 
 var sizeInBytes = Definition{
@@ -437,6 +454,7 @@ var lastAnalysis = Definition{
 	Type:        "last-analysis",
 	Description: "Time of last analysis. Format 2006-01-02T15:04:05.999999999Z",
 	DataType:    DATETIME,
+	Options:     []Option{pastTimeBounds},
 }
 
 var date = Definition{
@@ -498,12 +516,14 @@ var hostname = Definition{
 	Type:        "hostname",
 	Description: "A full host/dnsname of an attacker",
 	DataType:    FQDN,
+	Attributes:  []Definition{domain},
+	Correlate:   []string{"domain"},
 }
 
 var iban = Definition{
 	Type:        "iban",
 	Description: "International Bank Account Number",
-	DataType:    ISTR,
+	DataType:    IBAN,
 }
 
 var idNumber = Definition{
@@ -542,6 +562,13 @@ var macAddr = Definition{
 	Type:        "mac-address",
 	Description: "Network interface hardware address",
 	DataType:    MAC,
+	Attributes:  []Definition{macVendor},
+}
+
+var macVendor = Definition{
+	Type:        "mac-vendor",
+	Description: "Vendor of a network interface as registered in the IEEE OUI registry",
+	DataType:    ISTR,
 }
 
 var malwareFamily = Definition{
@@ -726,6 +753,30 @@ var hashSHA512256 = Definition{
 	DataType:    SHA512_256,
 }
 
+var hashImphash = Definition{
+	Type:        "imphash",
+	Description: "Hash MD5 of the import table of a PE file",
+	DataType:    MD5,
+}
+
+var hashSSDEEP = Definition{
+	Type:        "ssdeep",
+	Description: "Fuzzy hash ssdeep",
+	DataType:    SSDEEP,
+}
+
+var hashTLSH = Definition{
+	Type:        "tlsh",
+	Description: "Fuzzy hash TLSH",
+	DataType:    TLSH,
+}
+
+var hashAny = Definition{
+	Type:        "hash",
+	Description: "Hash of an unknown algorithm, moved to the attribute of its algorithm when it can be told apart",
+	DataType:    HASH,
+}
+
 var sshFingerprint = Definition{
 	Type:        "ssh-fingerprint",
 	Description: "A fingerprint of SSH key material",
@@ -813,7 +864,7 @@ var windowsServiceName = Definition{
 var xmr = Definition{
 	Type:        "xmr",
 	Description: "Monero address",
-	DataType:    STR,
+	DataType:    XMR,
 }
 
 var x509MD5 = Definition{
@@ -845,6 +896,7 @@ var breachDate = Definition{
 	Type: "breach-date",
 	Description: "Day the breach occurred",
 	DataType: DATE,
+	Options: []Option{pastTimeBounds},
 }
 
 var breachCount = Definition{
@@ -943,6 +995,7 @@ var Definitions = []Definition{
 	jabberID,
 	jarmFingerprint,
 	macAddr,
+	macVendor,
 	hashMD5,
 	mimeType,
 	mobileAppID,
@@ -971,6 +1024,10 @@ var Definitions = []Definition{
 	hashSHA3512,
 	hashSHA512224,
 	hashSHA512256,
+	hashImphash,
+	hashSSDEEP,
+	hashTLSH,
+	hashAny,
 	sshFingerprint,
 	ssr,
 	category,
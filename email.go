@@ -1,7 +1,6 @@
 package validations
 
 import (
-	"fmt"
 	"net/mail"
 	"strings"
 )
@@ -9,12 +8,12 @@ import (
 func ValidateEmail(value interface{}) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(EMAIL, value)
 	}
 
 	addr, err := mail.ParseAddress(strings.ToLower(v))
 	if err != nil {
-		return "", "", err
+		return "", "", wrapValidationError(EMAIL, CodeInvalid, value, err)
 	}
 
 	return addr.Address, GenerateSHA3256(addr.Address), nil
@@ -0,0 +1,66 @@
+package validations
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValidateEntity(t *testing.T) {
+	for _, e := range []Entity{eFile, eFilename, eMalware} {
+		_, errs := ValidateEntity(e)
+		for _, err := range errs {
+			t.Errorf("%s: %v", e.Type, err)
+		}
+	}
+
+	entity := Entity{
+		Type: "file",
+		Attributes: map[string]interface{}{
+			"file":    "21A1610CE915D5D5A8AB5B1F5B6D6715CF4F4E3BC0C868352A175279B1881AFE",
+			"md5":     "not a hash",
+			"unknown": "value",
+		},
+		Associations: []Entity{
+			{
+				Type:       "filename",
+				Attributes: map[string]interface{}{"filename": "invoice.pdf", "malware-family": 1},
+			},
+			{
+				Type:       "ip",
+				Attributes: map[string]interface{}{"ip": "8.8.8.8"},
+			},
+		},
+	}
+
+	normalized, errs := ValidateEntity(entity)
+	if len(errs) != 4 {
+		t.Errorf("expected 4 errors, got %d: %v", len(errs), errs)
+	}
+
+	var paths []string
+	for _, err := range errs {
+		var verr *ValidationError
+		if errors.As(err, &verr) {
+			paths = append(paths, verr.Path)
+		}
+	}
+
+	expected := []string{
+		"attributes.md5",
+		"attributes.unknown",
+		"associations[0].attributes.malware-family",
+		"associations[1]",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected errors at %v, got %v", expected, paths)
+	}
+
+	if normalized.Attributes["file"] != "21a1610ce915d5d5a8ab5b1f5b6d6715cf4f4e3bc0c868352a175279b1881afe" {
+		t.Errorf("file value was not normalized: %v", normalized.Attributes["file"])
+	}
+
+	if _, errs := ValidateEntity(Entity{Type: "unknown"}); len(errs) != 1 {
+		t.Errorf("expected unknown type error")
+	}
+}
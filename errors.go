@@ -0,0 +1,138 @@
+package validations
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	CodeNotString          = "not_string"
+	CodeNotInteger         = "not_integer"
+	CodeNotFloat           = "not_float"
+	CodeNotBoolean         = "not_boolean"
+	CodeEmpty              = "empty"
+	CodeInvalid            = "invalid"
+	CodeRegexMismatch      = "regex_mismatch"
+	CodeInvalidPattern     = "invalid_pattern"
+	CodeBadChecksum        = "bad_checksum"
+	CodePrivateIP          = "private_ip"
+	CodeLoopbackIP         = "loopback_ip"
+	CodeLinkLocalIP        = "link_local_ip"
+	CodeMulticastIP        = "multicast_ip"
+	CodeUnspecifiedIP      = "unspecified_ip"
+	CodeDocumentationIP    = "documentation_ip"
+	CodeSharedAddressIP    = "shared_address_ip"
+	CodeBenchmarkingIP     = "benchmarking_ip"
+	CodeReservedIP         = "reserved_ip"
+	CodeDeniedIP           = "denied_ip"
+	CodeInvalidPolicy      = "invalid_policy"
+	CodeMixedScript        = "mixed_script"
+	CodeNotRegistrable     = "not_registrable"
+	CodeOutOfRange         = "out_of_range"
+	CodeUnregistered       = "unregistered"
+	CodeAmbiguous          = "ambiguous"
+	CodeUnknownType        = "unknown_type"
+	CodeTypeMismatch       = "type_mismatch"
+	CodeUnknownValidator   = "unknown_validator"
+	CodeUnknownAttribute   = "unknown_attribute"
+	CodeUnknownAssociation = "unknown_association"
+	CodeMissingValue       = "missing_value"
+)
+
+// ValidationError describes why a value was rejected. Type and Path are only
+// set when the error comes from ValidateValue or ValidateEntity.
+type ValidationError struct {
+	Type     string      `json:"type,omitempty"`
+	DataType string      `json:"dataType,omitempty"`
+	Code     string      `json:"code"`
+	Value    interface{} `json:"value,omitempty"`
+	Path     string      `json:"path,omitempty"`
+	Message  string      `json:"message"`
+	Err      error       `json:"-"`
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path != "" {
+		return e.Path + ": " + e.Message
+	}
+	return e.Message
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+func newValidationError(dataType, code string, value interface{}, format string, args ...interface{}) *ValidationError {
+	return &ValidationError{
+		DataType: dataType,
+		Code:     code,
+		Value:    value,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+func wrapValidationError(dataType, code string, value interface{}, err error) *ValidationError {
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		e := *verr
+		e.DataType = dataType
+		return &e
+	}
+
+	return &ValidationError{
+		DataType: dataType,
+		Code:     code,
+		Value:    value,
+		Message:  err.Error(),
+		Err:      err,
+	}
+}
+
+func errNotString(dataType string, value interface{}) *ValidationError {
+	return newValidationError(dataType, CodeNotString, value, "value is not string: %v", value)
+}
+
+// asValidationError returns a copy of err as a ValidationError, filling the
+// fields that are not set yet.
+func asValidationError(err error, t, dataType string, value interface{}) *ValidationError {
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		verr = wrapValidationError(dataType, CodeInvalid, value, err)
+	}
+
+	e := *verr
+	if e.Type == "" {
+		e.Type = t
+	}
+	if e.DataType == "" {
+		e.DataType = dataType
+	}
+	if e.Value == nil {
+		e.Value = value
+	}
+	return &e
+}
+
+func withPath(err error, path string) error {
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	e := *verr
+	e.Path = path
+	return &e
+}
+
+func withPathPrefix(err error, prefix string) error {
+	if prefix == "" {
+		return err
+	}
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		return fmt.Errorf("%s: %w", prefix, err)
+	}
+
+	return withPath(err, joinPath(prefix, verr.Path))
+}
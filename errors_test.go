@@ -0,0 +1,49 @@
+package validations
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidationError(t *testing.T) {
+	var cases = []struct {
+		value    interface{}
+		t        string
+		dataType string
+		code     string
+	}{
+		{1, "ip", IP, CodeNotString},
+		{"10.0.0.1", "ip", IP, CodePrivateIP},
+		{"127.0.0.1", "ip", IP, CodeLoopbackIP},
+		{"10.0.0.0/8", "cidr", CIDR, CodePrivateIP},
+		{"zz", "md5", MD5, CodeRegexMismatch},
+		{"", "text", ISTR, CodeEmpty},
+		{"x", "unknown", "", CodeUnknownType},
+	}
+
+	for _, c := range cases {
+		_, _, err := ValidateValue(c.value, c.t)
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Errorf("%v: expected a ValidationError, got %v", c.value, err)
+			continue
+		}
+
+		if verr.Code != c.code || verr.DataType != c.dataType || verr.Type != c.t {
+			t.Errorf("%v: unexpected error %+v", c.value, verr)
+		}
+	}
+
+	_, errs := ValidateEntity(Entity{
+		Type:       "ip",
+		Attributes: map[string]interface{}{"ip": "8.8.8.8", "cidr": "192.168.0.0/16"},
+	})
+	var verr *ValidationError
+	if len(errs) != 1 || !errors.As(errs[0], &verr) {
+		t.Fatalf("expected one ValidationError, got %v", errs)
+	}
+
+	if verr.Path != "attributes.cidr" || verr.Code != CodePrivateIP {
+		t.Errorf("unexpected error %+v", verr)
+	}
+}
@@ -8,6 +8,7 @@ type Entity struct {
 	Correlate    []string               `json:"correlate"`
 	Tags         []string               `json:"tags"`
 	VisibleBy    []string               `json:"visibleBy"`
+	Warnings     []ValidationError      `json:"warnings,omitempty"`
 }
 
 var eMalware = Entity{
@@ -21,6 +22,15 @@ var eMalware = Entity{
 	Reputation: -3,
 }
 
+var eFilename = Entity{
+	Type: "filename",
+	Attributes: map[string]interface{}{
+		"filename":  "invoice.pdf",
+		"mime-type": "application/pdf",
+	},
+	Reputation: -3,
+}
+
 var eFile = Entity{
 	Type:       "file",
 	Reputation: -3,
@@ -31,7 +41,7 @@ var eFile = Entity{
 		"sha256":   "202492bdd391deac6c1e72eba9d039a7c60bcc61f1afa0d85269d8c4c5af1284",
 		"sha3-256": "21a1610ce915d5d5a8ab5b1f5b6d6715cf4f4e3bc0c868352a175279b1881afe",
 	},
-	Associations: []Entity{eMalware},
+	Associations: []Entity{eFilename},
 	Tags:         []string{"malware", "common-file"},
 	Correlate:    []string{"md5", "sha1", "sha256", "sha3-256"},
 }
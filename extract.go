@@ -0,0 +1,145 @@
+package validations
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Candidate is an indicator found in a text. Start and End are the byte
+// offsets of Raw in the text, Value is its normalized form. Alternatives
+// lists the other types a value may be, e.g. SHA3-256 for a 64 digit hash
+// reported as SHA-256.
+type Candidate struct {
+	Type         string      `json:"type"`
+	DataType     string      `json:"dataType"`
+	Value        interface{} `json:"value"`
+	ID           string      `json:"id"`
+	Raw          string      `json:"raw"`
+	Start        int         `json:"start"`
+	End          int         `json:"end"`
+	Alternatives []string    `json:"alternatives,omitempty"`
+}
+
+type extractor struct {
+	types []string
+	regex *regexp.Regexp
+}
+
+const (
+	extractDot    = `(?:\.|\[\.\]|\(\.\)|\{\.\}|\[dot\]|\(dot\))`
+	extractColon  = `(?::|\[:\])`
+	extractLabel  = `[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?`
+	extractDomain = `(?:` + extractLabel + extractDot + `)+(?:[a-z]{2,63}|xn--[a-z0-9-]{1,59})`
+	extractBase58 = `[1-9A-HJ-NP-Za-km-z]`
+)
+
+// extractors are tried in order, a match overlapping a value already
+// extracted is ignored.
+var extractors = []extractor{
+	{[]string{"url"}, regexp.MustCompile(`(?i)\b(?:h(?:tt|xx|\*\*)ps?|ftp)(?:://|\[:\]//|\[://\])[^\s<>"']+`)},
+	{[]string{"email"}, regexp.MustCompile(`(?i)\b[a-z0-9._%+-]+(?:@|\[@\]|\[at\]|\(at\))` + extractDomain + `\b`)},
+	{[]string{"cpe"}, regexp.MustCompile(`(?i)\bcpe:(?:2\.3:|/)[^\s<>"']+`)},
+	{[]string{"cve"}, regexp.MustCompile(`(?i)\bCVE-\d{4}-\d{4,}\b`)},
+	{[]string{"cidr"}, regexp.MustCompile(`\b\d{1,3}(?:` + extractDot + `\d{1,3}){3}/\d{1,2}\b`)},
+	{[]string{"ip"}, regexp.MustCompile(`\b\d{1,3}(?:` + extractDot + `\d{1,3}){3}\b`)},
+	{[]string{"ip"}, regexp.MustCompile(`(?i)\b(?:[0-9a-f]{1,4}` + extractColon + `){1,7}(?:` + extractColon + `?[0-9a-f]{1,4}){1,7}\b`)},
+	{[]string{"md5"}, regexp.MustCompile(`(?i)\b[0-9a-f]{32}\b`)},
+	{[]string{"sha1"}, regexp.MustCompile(`(?i)\b[0-9a-f]{40}\b`)},
+	{[]string{"sha224", "sha3-224", "sha512-224"}, regexp.MustCompile(`(?i)\b[0-9a-f]{56}\b`)},
+	{[]string{"sha256", "sha3-256", "sha512-256"}, regexp.MustCompile(`(?i)\b[0-9a-f]{64}\b`)},
+	{[]string{"sha384", "sha3-384"}, regexp.MustCompile(`(?i)\b[0-9a-f]{96}\b`)},
+	{[]string{"sha512", "sha3-512"}, regexp.MustCompile(`(?i)\b[0-9a-f]{128}\b`)},
+	{[]string{"btc"}, regexp.MustCompile(`\b(?:[13mn2]` + extractBase58 + `{25,34}|(?i:bc1|tb1)[02-9ac-hj-np-z]{11,71})\b`)},
+	{[]string{"xmr"}, regexp.MustCompile(`\b[4589A]` + extractBase58 + `{94}(?:` + extractBase58 + `{11})?\b`)},
+	{[]string{"dash"}, regexp.MustCompile(`\b[X7y8]` + extractBase58 + `{33}\b`)},
+	{[]string{"hostname"}, regexp.MustCompile(`(?i)\b` + extractDomain + `\b`)},
+}
+
+// Extract scans a text, e.g. a report or an email body, for indicators and
+// returns the valid ones ordered by offset.
+func Extract(text string, opts ...Option) []Candidate {
+	return DefaultRegistry.Extract(text, opts...)
+}
+
+// Extract scans a text for indicators, validates every match against the
+// registered definitions and returns the valid ones ordered by offset.
+// Defanged values are refanged before being validated.
+func (r *Registry) Extract(text string, opts ...Option) []Candidate {
+	var candidates []Candidate
+	for _, e := range extractors {
+		for _, loc := range e.regex.FindAllStringIndex(text, -1) {
+			start, end := loc[0], trimCandidate(text, loc[0], loc[1])
+			if start == end || overlaps(candidates, start, end) {
+				continue
+			}
+
+			c, ok := r.extractCandidate(text[start:end], e.types, opts)
+			if !ok {
+				continue
+			}
+			c.Start, c.End = start, end
+			candidates = append(candidates, c)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Start < candidates[j].Start
+	})
+	return candidates
+}
+
+func (r *Registry) extractCandidate(raw string, types []string, opts []Option) (Candidate, bool) {
+	value := Refang(raw)
+
+	t := types[0]
+	if t == "hostname" && !isKnownSuffix(value) {
+		return Candidate{}, false
+	}
+
+	def, ok := r.Definition(t)
+	if !ok {
+		return Candidate{}, false
+	}
+
+	nv, id, err := r.validateDefinition(value, def, opts)
+	if err != nil {
+		return Candidate{}, false
+	}
+
+	return Candidate{
+		Type:         t,
+		DataType:     def.DataType,
+		Value:        nv,
+		ID:           id,
+		Raw:          raw,
+		Alternatives: types[1:],
+	}, true
+}
+
+// trimCandidate drops the trailing punctuation that usually follows an
+// indicator in prose, e.g. the dot ending a sentence.
+func trimCandidate(text string, start, end int) int {
+	for end > start {
+		switch text[end-1] {
+		case '.', ',', ';', ':', '!', '?', ')', '}', '>':
+		case ']':
+			if strings.HasSuffix(text[start:end], "[.]") {
+				return end
+			}
+		default:
+			return end
+		}
+		end--
+	}
+	return end
+}
+
+func overlaps(candidates []Candidate, start, end int) bool {
+	for _, c := range candidates {
+		if start < c.End && c.Start < end {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package validations
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	text := "The dropper hxxp://evil[.]com/a.php?id=1 (mirrors hxxp[://]evil[.]org/a and " +
+		"hxxps[://]evil[.]net/b) was hosted on 1.2.3[.]4 and 2001:db8::1, " +
+		"2606[:]4700[:][:]1111 and portal.gov[.]bd, " +
+		"C2 at update.evil-cdn[.]net. Contact admin[@]evil.com about CVE-2021-44228. " +
+		"Sample 44d88612fea8a8f36de82e1278abb02f and " +
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855, " +
+		"range 8.8.8.0/24, ransom to 1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2. " +
+		"Not an IP: 999.1.1.1, not a domain: file.exe, std::vector."
+
+	var expected = []struct {
+		Type  string
+		Value interface{}
+	}{
+		{"url", "http://evil.com/a.php?id=1"},
+		{"url", "http://evil.org/a"},
+		{"url", "https://evil.net/b"},
+		{"ip", "1.2.3.4"},
+		{"ip", "2001:db8::1"},
+		{"ip", "2606:4700::1111"},
+		{"hostname", "portal.gov.bd"},
+		{"hostname", "update.evil-cdn.net"},
+		{"email", "admin@evil.com"},
+		{"cve", "CVE-2021-44228"},
+		{"md5", "44d88612fea8a8f36de82e1278abb02f"},
+		{"sha256", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{"cidr", "8.8.8.0/24"},
+		{"btc", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+	}
+
+	candidates := Extract(text)
+	if len(candidates) != len(expected) {
+		t.Fatalf("expected %d candidates, got %+v", len(expected), candidates)
+	}
+
+	for i, c := range candidates {
+		if c.Type != expected[i].Type || c.Value != expected[i].Value {
+			t.Errorf("expected %s %v, got %s %v", expected[i].Type, expected[i].Value, c.Type, c.Value)
+		}
+
+		if text[c.Start:c.End] != c.Raw {
+			t.Errorf("%s: wrong offsets %d-%d", c.Raw, c.Start, c.End)
+		}
+	}
+
+	if !reflect.DeepEqual(candidates[11].Alternatives, []string{"sha3-256", "sha512-256"}) {
+		t.Errorf("unexpected alternatives %v", candidates[11].Alternatives)
+	}
+}
@@ -12,7 +12,7 @@ func ValidateFloat(value interface{}) (float64, string, error) {
 	}
 	v, ok := value.(float64)
 	if !ok {
-		return 0, "", fmt.Errorf("value is not float: %v", value)
+		return 0, "", newValidationError(FLOAT, CodeNotFloat, value, "value is not float: %v", value)
 	}
 
 	return v, GenerateSHA3256(fmt.Sprint(v)), nil
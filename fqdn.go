@@ -1,22 +1,271 @@
 package validations
 
 import (
-	"fmt"
 	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+)
+
+// idnaProfile follows UTS #46 lookup, except that labels may have hyphens in
+// their third and fourth positions, e.g. ab--cd.com. Leading and trailing
+// hyphens are rejected by ParseFQDN.
+var idnaProfile = idna.New(
+	idna.MapForLookup(),
+	idna.CheckHyphens(false),
+	idna.Transitional(false),
+	idna.BidiRule(),
+	idna.StrictDomainName(true),
+	idna.VerifyDNSLength(true),
 )
 
+// Domain is a fully qualified domain name in A-label (punycode) and U-label
+// (Unicode) form. MixedScript flags names having a label that mixes scripts,
+// a common sign of homograph attacks.
+type Domain struct {
+	ASCII       string `json:"ascii"`
+	Unicode     string `json:"unicode"`
+	MixedScript bool   `json:"mixedScript"`
+}
+
+// scriptSets lists the script combinations that are allowed in a single
+// label, following the UTS #39 highly restrictive profile.
+var scriptSets = [][]string{
+	{"Latin", "Han", "Hiragana", "Katakana"},
+	{"Latin", "Han", "Bopomofo"},
+	{"Latin", "Han", "Hangul"},
+}
+
+// ParseFQDN processes a Unicode or punycode domain name following UTS #46
+// and IDNA2008.
+func ParseFQDN(s string) (Domain, error) {
+	name := strings.TrimSuffix(strings.TrimSpace(s), ".")
+
+	ascii, plain := asciiFQDN(name)
+	if !plain {
+		var err error
+		if ascii, err = idnaProfile.ToASCII(name); err != nil {
+			return Domain{}, wrapValidationError(FQDN, CodeInvalid, s, err)
+		}
+	}
+
+	if len(ascii) > 253 {
+		return Domain{}, newValidationError(FQDN, CodeInvalid, s, "domain name longer than 253 octets: %s", s)
+	}
+
+	labels := strings.Split(ascii, ".")
+	if len(labels) < 2 {
+		return Domain{}, newValidationError(FQDN, CodeInvalid, s, "domain name is not fully qualified: %s", s)
+	}
+
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 {
+			return Domain{}, newValidationError(FQDN, CodeInvalid, s, "invalid domain label length: %s", s)
+		}
+
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return Domain{}, newValidationError(FQDN, CodeInvalid, s, "domain label starts or ends with a hyphen: %s", s)
+		}
+	}
+
+	if !isTLD(labels[len(labels)-1]) {
+		return Domain{}, newValidationError(FQDN, CodeInvalid, s, "invalid top level domain: %s", s)
+	}
+
+	if plain {
+		return Domain{ASCII: ascii, Unicode: ascii}, nil
+	}
+
+	unicodeName, err := idnaProfile.ToUnicode(ascii)
+	if err != nil {
+		return Domain{}, wrapValidationError(FQDN, CodeInvalid, s, err)
+	}
+
+	d := Domain{ASCII: ascii, Unicode: unicodeName}
+	for _, label := range strings.Split(unicodeName, ".") {
+		if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return Domain{}, newValidationError(FQDN, CodeInvalid, s, "domain label starts or ends with a hyphen: %s", s)
+		}
+
+		if isMixedScript(label) {
+			d.MixedScript = true
+		}
+	}
+
+	return d, nil
+}
+
+func isTLD(label string) bool {
+	if strings.HasPrefix(label, "xn--") {
+		return true
+	}
+
+	if len(label) < 2 {
+		return false
+	}
+
+	for _, c := range label {
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// asciiFQDN lower cases a name made of letters, digits and hyphens only,
+// which UTS #46 maps to itself. It reports false for the other names, A-labels
+// included, that need the IDNA processing.
+func asciiFQDN(name string) (string, bool) {
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || label[0] == '-' || label[len(label)-1] == '-' || (len(label) >= 4 && strings.EqualFold(label[:4], "xn--")) {
+			return "", false
+		}
+
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return "", false
+			}
+		}
+	}
+	return strings.ToLower(name), true
+}
+
+// labelScripts are the scripts told apart by isMixedScript, the letters of
+// any other script count as a single one.
+var labelScripts = []struct {
+	name  string
+	table *unicode.RangeTable
+}{
+	{"Latin", unicode.Latin},
+	{"Cyrillic", unicode.Cyrillic},
+	{"Greek", unicode.Greek},
+	{"Han", unicode.Han},
+	{"Hiragana", unicode.Hiragana},
+	{"Katakana", unicode.Katakana},
+	{"Bopomofo", unicode.Bopomofo},
+	{"Hangul", unicode.Hangul},
+	{"Armenian", unicode.Armenian},
+	{"Georgian", unicode.Georgian},
+	{"Hebrew", unicode.Hebrew},
+	{"Arabic", unicode.Arabic},
+	{"Devanagari", unicode.Devanagari},
+	{"Thai", unicode.Thai},
+}
+
+func labelScript(r rune) string {
+	if r < 0x80 {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return "Latin"
+		}
+		return ""
+	}
+
+	if unicode.In(r, unicode.Common, unicode.Inherited) {
+		return ""
+	}
+
+	for _, s := range labelScripts {
+		if unicode.Is(s.table, r) {
+			return s.name
+		}
+	}
+	return "Other"
+}
+
+func isMixedScript(label string) bool {
+	scripts := map[string]bool{}
+	for _, r := range label {
+		if script := labelScript(r); script != "" {
+			scripts[script] = true
+		}
+	}
+
+	if len(scripts) <= 1 {
+		return false
+	}
+
+	for _, set := range scriptSets {
+		allowed := true
+		for script := range scripts {
+			if !hasString(set, script) {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			return false
+		}
+	}
+	return true
+}
+
+func hasString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateFQDN validates a Unicode or punycode domain name and returns its
+// A-label form.
 func ValidateFQDN(value interface{}) (string, string, error) {
+	return validateFQDN(value, Options{})
+}
+
+func validateFQDN(value interface{}, opts Options) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(FQDN, value)
 	}
 
-	v = strings.ToLower(v)
+	d, err := ParseFQDN(v)
+	if err != nil {
+		return "", "", err
+	}
 
-	e := ValidateRegEx(`^(?i)[a-z0-9]+(([-]{1,2}[a-z0-9]+)*([\.]{1}[a-z0-9]+)*)*(\.[a-z]{2,20})$`, v)
-	if e != nil {
-		return "", "", e
+	if d.MixedScript && opts.RejectMixedScript {
+		return "", "", newValidationError(FQDN, CodeMixedScript, value, "domain name mixes scripts: %s", v)
 	}
 
-	return v, GenerateSHA3256(v), nil
+	id := GenerateSHA3256(d.ASCII)
+	if opts.UnicodeFQDN {
+		return d.Unicode, id, nil
+	}
+	return d.ASCII, id, nil
+}
+
+// domainAttributes returns the attribute types of a definition, itself
+// included, holding domain names.
+func domainAttributes(def Definition) []string {
+	var types []string
+	for _, d := range append([]Definition{def}, def.Attributes...) {
+		if d.DataType == FQDN || d.DataType == DOMAIN {
+			types = append(types, d.Type)
+		}
+	}
+	return types
+}
+
+// mixedScriptHook reports in the entity warnings the domain names mixing
+// scripts, unless WithMixedScriptRejection already rejected them.
+func mixedScriptHook(types []string) EntityHook {
+	return func(entity *Entity) []error {
+		for _, t := range types {
+			v, ok := entity.Attributes[t].(string)
+			if !ok {
+				continue
+			}
+
+			if d, err := ParseFQDN(v); err == nil && d.MixedScript {
+				w := newValidationError(FQDN, CodeMixedScript, v, "domain name mixes scripts: %s", d.Unicode)
+				w.Type = t
+				w.Path = joinPath("", "attributes", t)
+				entity.Warnings = append(entity.Warnings, *w)
+			}
+		}
+		return nil
+	}
 }
@@ -0,0 +1,132 @@
+package validations
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateFQDN(t *testing.T) {
+	var validDomains = map[string]string{
+		"Example.COM":              "example.com",
+		"www.example.com.":         "www.example.com",
+		"bücher.de":                "xn--bcher-kva.de",
+		"xn--bcher-kva.de":         "xn--bcher-kva.de",
+		"例え.テスト":                   "xn--r8jz45g.xn--zckzah",
+		"пример.испытание":         "xn--e1afmkfd.xn--80akhbyknj4f",
+		"my-host.example.co.uk":    "my-host.example.co.uk",
+		"a.b.c.d.e.f.example.info": "a.b.c.d.e.f.example.info",
+		"ab--cd.com":               "ab--cd.com",
+	}
+
+	var invalidDomains = []string{
+		"localhost",
+		"example.c",
+		"example.123",
+		"-example.com",
+		"example-.com",
+		"www.-example.com",
+		"-bücher.de",
+		"exa_mple.com",
+		"exa mple.com",
+		strings.Repeat("a", 64) + ".com",
+		strings.Repeat("abcdefghi.", 26) + "com",
+	}
+
+	for in, out := range validDomains {
+		v, _, err := ValidateFQDN(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+		}
+
+		if v != out {
+			t.Errorf("expected %s, got %s", out, v)
+		}
+	}
+
+	for _, in := range invalidDomains {
+		if _, _, err := ValidateFQDN(in); err == nil {
+			t.Errorf("%s: this should return an error", in)
+		}
+	}
+
+	u, id, err := ValidateValue("xn--bcher-kva.de", "domain", WithUnicodeFQDN())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u != "bücher.de" || id != GenerateSHA3256("xn--bcher-kva.de") {
+		t.Errorf("unexpected unicode domain %v %s", u, id)
+	}
+
+	d, err := ParseFQDN("pаypal.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !d.MixedScript {
+		t.Errorf("%s should be flagged as mixed script", d.Unicode)
+	}
+
+	_, _, err = ValidateValue("pаypal.com", "domain", WithMixedScriptRejection())
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Code != CodeMixedScript {
+		t.Errorf("expected a mixed script error, got %v", err)
+	}
+
+	if d, _ := ParseFQDN("東京タワー.jp"); d.MixedScript {
+		t.Errorf("%s should not be flagged as mixed script", d.Unicode)
+	}
+}
+
+func TestMixedScriptWarning(t *testing.T) {
+	e, errs := ValidateEntity(Entity{
+		Type:       "hostname",
+		Attributes: map[string]interface{}{"hostname": "pаypal.com"},
+	})
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+
+	// The registrable domain filled by the hostname hook mixes scripts too.
+	var paths []string
+	for _, w := range e.Warnings {
+		if w.Code != CodeMixedScript {
+			t.Errorf("unexpected warning %v", w)
+		}
+		paths = append(paths, w.Path)
+	}
+
+	if !reflect.DeepEqual(paths, []string{"attributes.hostname", "attributes.domain"}) {
+		t.Errorf("expected %s warnings, got %v", CodeMixedScript, e.Warnings)
+	}
+
+	e, errs = ValidateEntity(Entity{
+		Type:       "hostname",
+		Attributes: map[string]interface{}{"hostname": "bücher.de"},
+	})
+	if len(errs) != 0 || len(e.Warnings) != 0 {
+		t.Errorf("unexpected warnings %v %v", e.Warnings, errs)
+	}
+}
+
+func TestASCIIFQDN(t *testing.T) {
+	for _, in := range []string{"WWW.Example.com", "a-b.c0m", "1.com", "-a.com", "a-.com", "ab--c.com", "xn--bcher-kva.example", "a_b.com", "a..com", "bücher.example"} {
+		ascii, plain := asciiFQDN(in)
+		idn, err := idnaProfile.ToASCII(in)
+		if plain && (err != nil || ascii != idn) {
+			t.Errorf("%s: fast path returned %s, IDNA returned %s %v", in, ascii, idn, err)
+		}
+	}
+
+	if isMixedScript("example") || !isMixedScript("pаypal") || isMixedScript("日本語テキスト") {
+		t.Error("unexpected mixed script detection")
+	}
+}
+
+func BenchmarkValidateFQDN(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ValidateFQDN("www.example.com")
+	}
+}
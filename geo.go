@@ -0,0 +1,145 @@
+package validations
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// coordinatePrecision is the number of decimals coordinates are normalized
+// to, about 11 cm at the equator.
+const coordinatePrecision = 6
+
+var dmsReplacer = strings.NewReplacer("°", " ", "º", " ", "D", " ", "′", " ", "'", " ", "M", " ", "″", " ", `"`, " ")
+
+// dmsSecondsRegex matches the S marker of seconds written with letters, as
+// in 40D26M46S, which would be read as the southern hemisphere otherwise.
+var dmsSecondsRegex = regexp.MustCompile(`(M\s*[0-9.]+)\s*S`)
+
+// ParseCoordinate parses a coordinate in decimal degrees ("-73.9857") or in
+// degrees, minutes and seconds ("40°26′46″N", "40 26.767 N"). pos and neg are
+// the hemisphere letters, N and S for latitudes or E and W for longitudes.
+func ParseCoordinate(s string, pos, neg byte) (float64, bool) {
+	v := strings.ToUpper(strings.TrimSpace(s))
+	v = strings.TrimSpace(dmsSecondsRegex.ReplaceAllString(v, "$1 "))
+	if v == "" {
+		return 0, false
+	}
+
+	sign := 0.0
+	switch {
+	case v[len(v)-1] == pos || v[len(v)-1] == neg:
+		sign = hemisphereSign(v[len(v)-1], pos)
+		v = v[:len(v)-1]
+	case v[0] == pos || v[0] == neg:
+		sign = hemisphereSign(v[0], pos)
+		v = v[1:]
+	}
+
+	fields := strings.Fields(dmsReplacer.Replace(v))
+	if len(fields) == 0 || len(fields) > 3 {
+		return 0, false
+	}
+
+	if sign == 0 {
+		sign = 1
+		if strings.HasPrefix(fields[0], "-") {
+			sign = -1
+		}
+		fields[0] = strings.TrimLeft(fields[0], "+-")
+	}
+
+	var degrees float64
+	for i, f := range fields {
+		if f == "" || f[0] < '0' || f[0] > '9' {
+			return 0, false
+		}
+
+		n, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return 0, false
+		}
+
+		if i < len(fields)-1 && n != math.Trunc(n) {
+			return 0, false
+		}
+
+		if i > 0 && n >= 60 {
+			return 0, false
+		}
+		degrees += n / math.Pow(60, float64(i))
+	}
+
+	return sign * degrees, true
+}
+
+func hemisphereSign(h, pos byte) float64 {
+	if h == pos {
+		return 1
+	}
+	return -1
+}
+
+func ValidateLatitude(value interface{}) (float64, string, error) {
+	return validateCoordinate(LATITUDE, value, 90, 'N', 'S')
+}
+
+func ValidateLongitude(value interface{}) (float64, string, error) {
+	return validateCoordinate(LONGITUDE, value, 180, 'E', 'W')
+}
+
+func validateCoordinate(dataType string, value interface{}, limit float64, pos, neg byte) (float64, string, error) {
+	var f float64
+	switch v := value.(type) {
+	case float64:
+		f = v
+	case int64:
+		f = float64(v)
+	case int:
+		f = float64(v)
+	case string:
+		var ok bool
+		if f, ok = ParseCoordinate(v, pos, neg); !ok {
+			return 0, "", newValidationError(dataType, CodeInvalid, value, "invalid coordinate: %s", v)
+		}
+	default:
+		return 0, "", newValidationError(dataType, CodeNotFloat, value, "value is not a coordinate: %v", value)
+	}
+
+	if math.IsNaN(f) || math.Abs(f) > limit {
+		return 0, "", newValidationError(dataType, CodeOutOfRange, value, "%s out of range [-%g, %g]: %v", strings.ToLower(dataType), limit, limit, value)
+	}
+
+	s := strconv.FormatFloat(f, 'f', coordinatePrecision, 64)
+	f, _ = strconv.ParseFloat(s, 64)
+	if f == 0 {
+		// -0 and values rounding to it have the ID of 0.
+		f = 0
+		s = strconv.FormatFloat(f, 'f', coordinatePrecision, 64)
+	}
+	return f, GenerateSHA3256(s), nil
+}
+
+// geoPointHook requires the latitude and longitude attributes to be given
+// together.
+func geoPointHook(entity *Entity) []error {
+	_, hasLat := entity.Attributes[latitude.Type]
+	_, hasLng := entity.Attributes[longitude.Type]
+	if hasLat == hasLng {
+		return nil
+	}
+
+	missing, dataType := longitude.Type, LONGITUDE
+	if !hasLat {
+		missing, dataType = latitude.Type, LATITUDE
+	}
+
+	return []error{&ValidationError{
+		Type:     missing,
+		DataType: dataType,
+		Code:     CodeMissingValue,
+		Path:     joinPath("", "attributes", missing),
+		Message:  "latitude and longitude must be given together",
+	}}
+}
@@ -0,0 +1,116 @@
+package validations
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestValidateLatitude(t *testing.T) {
+	var validLatitudes = map[interface{}]float64{
+		40.446195:     40.446195,
+		int64(-90):    -90,
+		"12.3456789":  12.345679,
+		"-33.8688":    -33.8688,
+		"40°26′46″N":  40.446111,
+		`40°26'46"S`:  -40.446111,
+		"N 40 26 46":  40.446111,
+		"40d26m46sN":  40.446111,
+		"40d26m46s":   40.446111,
+		"40d26m46sS":  -40.446111,
+		"S40d26m46s":  -40.446111,
+		"40 26.767 N": 40.446117,
+		"90":          90,
+	}
+
+	var invalidLatitudes = []interface{}{512.3, -90.5, "91", "40°26′46″E", "40°61′N", "40.5°26′N", "-40°26′N", "north", "", true}
+
+	for in, out := range validLatitudes {
+		v, id, err := ValidateLatitude(in)
+		if err != nil {
+			t.Errorf("%v: %v", in, err)
+		}
+
+		if v != out {
+			t.Errorf("%v: expected %v, got %v", in, out, v)
+		}
+
+		if _, id2, _ := ValidateLatitude(v); id != id2 {
+			t.Errorf("%v: normalized value has a different ID", in)
+		}
+	}
+
+	for _, in := range invalidLatitudes {
+		if _, _, err := ValidateLatitude(in); err == nil {
+			t.Errorf("%v: this should return an error", in)
+		}
+	}
+
+	_, zero, _ := ValidateLatitude(0.0)
+	for _, in := range []interface{}{math.Copysign(0, -1), "-0.0", "-0.0000001"} {
+		if v, id, err := ValidateLatitude(in); err != nil || id != zero || math.Signbit(v) {
+			t.Errorf("%v: expected the ID of 0, got %v %v", in, v, err)
+		}
+	}
+
+	var verr *ValidationError
+	if _, _, err := ValidateLatitude(512.3); !errors.As(err, &verr) || verr.Code != CodeOutOfRange {
+		t.Errorf("expected an out of range error, got %v", err)
+	}
+}
+
+func TestValidateLongitude(t *testing.T) {
+	var validLongitudes = map[interface{}]float64{
+		-73.985656:     -73.985656,
+		"179.9999999":  180,
+		"73°59′8.36″W": -73.985656,
+		"E 151 12 36":  151.21,
+		"73d59m8.36s":  73.985656,
+		"73d59m8.36sW": -73.985656,
+	}
+
+	for in, out := range validLongitudes {
+		v, _, err := ValidateLongitude(in)
+		if err != nil {
+			t.Errorf("%v: %v", in, err)
+		}
+
+		if v != out {
+			t.Errorf("%v: expected %v, got %v", in, out, v)
+		}
+	}
+
+	for _, in := range []interface{}{180.5, "181", "73°59′8″N"} {
+		if _, _, err := ValidateLongitude(in); err == nil {
+			t.Errorf("%v: this should return an error", in)
+		}
+	}
+}
+
+func TestGeoPoint(t *testing.T) {
+	e, errs := ValidateEntity(Entity{
+		Type: "cidr",
+		Attributes: map[string]interface{}{
+			"cidr":      "8.8.8.0/24",
+			"latitude":  "37°24′N",
+			"longitude": "122°5′W",
+		},
+	})
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+
+	if e.Attributes["latitude"] != 37.4 || e.Attributes["longitude"] != -122.083333 {
+		t.Errorf("unexpected coordinates %v, %v", e.Attributes["latitude"], e.Attributes["longitude"])
+	}
+
+	_, errs = ValidateEntity(Entity{
+		Type:       "cidr",
+		Attributes: map[string]interface{}{"cidr": "8.8.8.0/24", "latitude": 37.4},
+	})
+
+	var verr *ValidationError
+	if len(errs) != 1 || !errors.As(errs[0], &verr) || verr.Path != "attributes.longitude" || verr.Code != CodeMissingValue {
+		t.Errorf("expected a missing longitude error, got %v", errs)
+	}
+}
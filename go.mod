@@ -5,6 +5,7 @@ go 1.19
 require (
 	github.com/google/uuid v1.3.0
 	golang.org/x/crypto v0.10.0
+	golang.org/x/net v0.11.0
 	golang.org/x/text v0.10.0
 )
 
@@ -0,0 +1,135 @@
+package validations
+
+import (
+	"strings"
+)
+
+// hashFormats lists the hash formats with the attribute types of the
+// algorithms sharing each one, the most common first.
+var hashFormats = []struct {
+	types    []string
+	validate func(value interface{}) (string, string, error)
+}{
+	{[]string{hashMD5.Type, hashImphash.Type}, validateMD5Digest},
+	{[]string{hashSHA1.Type}, ValidateSHA1},
+	{[]string{hashSHA224.Type, hashSHA3224.Type, hashSHA512224.Type}, ValidateSHA224},
+	{[]string{hashSHA256.Type, hashSHA3256.Type, hashSHA512256.Type}, ValidateSHA256},
+	{[]string{hashSHA384.Type, hashSHA3384.Type}, ValidateSHA384},
+	{[]string{hashSHA512.Type, hashSHA3512.Type}, ValidateSHA512},
+	{[]string{hashTLSH.Type}, ValidateTLSH},
+	{[]string{hashSSDEEP.Type}, ValidateSSDEEP},
+}
+
+// validateMD5Digest only accepts full 128 bit MD5 digests, unlike
+// ValidateMD5.
+func validateMD5Digest(value interface{}) (string, string, error) {
+	if v, ok := value.(string); !ok || len(v) != 32 {
+		return "", "", newValidationError(MD5, CodeInvalid, value, "invalid MD5: %v", value)
+	}
+	return ValidateMD5(value)
+}
+
+func hashFormat(s string) int {
+	for i, f := range hashFormats {
+		if _, _, err := f.validate(s); err == nil {
+			return i
+		}
+	}
+	return -1
+}
+
+// DetectHash returns the attribute types of the algorithms that may have
+// produced a hash, e.g. sha256, sha3-256 and sha512-256 for 64 hexadecimal
+// digits. It returns nil if s is not a known hash format.
+func DetectHash(s string) []string {
+	i := hashFormat(strings.TrimSpace(s))
+	if i < 0 {
+		return nil
+	}
+	return append([]string{}, hashFormats[i].types...)
+}
+
+func ValidateHash(value interface{}) (string, string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return "", "", errNotString(HASH, value)
+	}
+
+	v = strings.TrimSpace(v)
+	i := hashFormat(v)
+	if i < 0 {
+		return "", "", newValidationError(HASH, CodeInvalid, value, "unknown hash format: %s", value)
+	}
+
+	return hashFormats[i].validate(v)
+}
+
+// hashCandidates returns the attribute types of the algorithms that may
+// have produced h. It returns nil if h is not a known hash format or if an
+// attribute of the entity already holds h. A 32 digit hash is taken as an
+// MD5.
+func hashCandidates(entity *Entity, h string) []string {
+	types := DetectHash(h)
+	for _, t := range types {
+		if entity.Attributes[t] == h {
+			return nil
+		}
+	}
+
+	if len(types) > 0 && types[0] == hashMD5.Type {
+		types = types[:1]
+	}
+	return types
+}
+
+// ambiguousHash reports in the entity warnings that the hash at key may
+// have been produced by several algorithms.
+func ambiguousHash(entity *Entity, key, h string, types []string) {
+	w := newValidationError(HASH, CodeAmbiguous, h, "ambiguous hash, may be %s", strings.Join(types, ", "))
+	w.Type = key
+	w.Path = joinPath("", "attributes", key)
+	entity.Warnings = append(entity.Warnings, *w)
+}
+
+// fileHashHook fills the digest attribute a file is identified by, and
+// moves a hash of unknown algorithm to the attribute of its algorithm.
+// Hashes that several algorithms may have produced are kept and reported in
+// the entity warnings.
+func fileHashHook(entity *Entity) []error {
+	if v, ok := entity.Attributes[entity.Type].(string); ok {
+		switch types := hashCandidates(entity, v); {
+		case len(types) > 1:
+			ambiguousHash(entity, entity.Type, v, types)
+		case len(types) == 1 && entity.Attributes[types[0]] == nil:
+			entity.Attributes[types[0]] = v
+		}
+	}
+
+	h, ok := entity.Attributes[hashAny.Type].(string)
+	if !ok {
+		return nil
+	}
+
+	if DetectHash(h) == nil {
+		return nil
+	}
+
+	types := hashCandidates(entity, h)
+	if len(types) == 0 || entity.Attributes[entity.Type] == h {
+		delete(entity.Attributes, hashAny.Type)
+		return nil
+	}
+
+	switch t := types[0]; {
+	case len(types) > 1:
+		ambiguousHash(entity, hashAny.Type, h, types)
+	case entity.Attributes[t] != nil:
+		err := newValidationError(HASH, CodeInvalid, h, "hash conflicts with %s %v", t, entity.Attributes[t])
+		err.Type = hashAny.Type
+		return []error{withPath(err, joinPath("", "attributes", hashAny.Type))}
+	default:
+		entity.Attributes[t] = h
+		delete(entity.Attributes, hashAny.Type)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package validations
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDetectHash(t *testing.T) {
+	var cases = map[string][]string{
+		"D41D8CD98F00B204E9800998ECF8427E":                                         {"md5", "imphash"},
+		"da39a3ee5e6b4b0d3255bfef95601890afd80709":                                 {"sha1"},
+		"d14a028c2a3a2bc9476102bb288234c415a2b01f828ea62ac5b3e42f":                 {"sha224", "sha3-224", "sha512-224"},
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855":         {"sha256", "sha3-256", "sha512-256"},
+		"T1A2F0D3E1B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F708192": {"tlsh"},
+		"96:s4Ud1Lj96tHHlZDrwciQmA+4uy1I0G4HYuL8N3TzS8QsO/wqWXLcMSx:sF1C6g/D":      {"ssdeep"},
+		"3::":               {"ssdeep"},
+		"":                  nil,
+		"d41d8cd98f00b204":  nil,
+		"5:abc:def":         nil,
+		"not a hash at all": nil,
+	}
+
+	for in, out := range cases {
+		if types := DetectHash(in); !reflect.DeepEqual(types, out) {
+			t.Errorf("%s: expected %v, got %v", in, out, types)
+		}
+	}
+}
+
+func TestValidateHash(t *testing.T) {
+	var validHashes = map[string]string{
+		"D41D8CD98F00B204E9800998ECF8427E":                                         "d41d8cd98f00b204e9800998ecf8427e",
+		"t1a2f0d3e1b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192": "T1A2F0D3E1B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F708192",
+		"A2F0D3E1B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F708192":   "T1A2F0D3E1B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F708192",
+		" 3072:abc+/:xyz ": "3072:abc+/:xyz",
+	}
+
+	for in, out := range validHashes {
+		v, id, err := ValidateHash(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+
+		if v != out || id != GenerateSHA3256(out) {
+			t.Errorf("%s: expected %s, got %s", in, out, v)
+		}
+	}
+
+	for _, in := range []string{"", "xyz", "9:abc:def", "0:abc:def"} {
+		if _, _, err := ValidateHash(in); err == nil {
+			t.Errorf("%s: this should return an error", in)
+		}
+	}
+}
+
+func TestFileHash(t *testing.T) {
+	const (
+		md5    = "fb92636db83298a4215a2f5ffa2527b1"
+		sha1   = "93a8f022b553f786bf077ff55616350727f8764a"
+		sha256 = "202492bdd391deac6c1e72eba9d039a7c60bcc61f1afa0d85269d8c4c5af1284"
+		sha3   = "21a1610ce915d5d5a8ab5b1f5b6d6715cf4f4e3bc0c868352a175279b1881afe"
+	)
+
+	e, errs := ValidateEntity(Entity{
+		Type:       "file",
+		Attributes: map[string]interface{}{"file": sha3, "hash": sha1},
+	})
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+
+	if e.Attributes["sha1"] != sha1 || e.Attributes["sha3-256"] != nil || e.Attributes["hash"] != nil {
+		t.Errorf("unexpected attributes %v", e.Attributes)
+	}
+
+	if len(e.Warnings) != 1 || e.Warnings[0].Code != CodeAmbiguous || e.Warnings[0].Path != "attributes.file" {
+		t.Errorf("expected %s warning, got %v", CodeAmbiguous, e.Warnings)
+	}
+
+	e, errs = ValidateEntity(Entity{
+		Type:       "file",
+		Attributes: map[string]interface{}{"file": sha3, "sha3-256": sha3, "hash": sha3},
+	})
+	if len(errs) != 0 || len(e.Warnings) != 0 || e.Attributes["hash"] != nil {
+		t.Errorf("hash of the file value should be resolved, got %v %v %v", e.Attributes, e.Warnings, errs)
+	}
+
+	e, errs = ValidateEntity(Entity{
+		Type:       "file",
+		Attributes: map[string]interface{}{"file": strings.ToUpper(md5)},
+	})
+	if len(errs) != 0 || len(e.Warnings) != 0 || e.Attributes["file"] != md5 || e.Attributes["md5"] != md5 {
+		t.Errorf("32 digit file value should be routed to md5, got %v %v %v", e.Attributes, e.Warnings, errs)
+	}
+
+	e, errs = ValidateEntity(Entity{
+		Type:       "file",
+		Attributes: map[string]interface{}{"file": md5, "hash": strings.ToUpper(md5)},
+	})
+	if len(errs) != 0 || len(e.Warnings) != 0 || e.Attributes["md5"] != md5 || e.Attributes["hash"] != nil {
+		t.Errorf("32 digit hash should be routed to md5, got %v %v %v", e.Attributes, e.Warnings, errs)
+	}
+
+	e, errs = ValidateEntity(Entity{
+		Type:       "file",
+		Attributes: map[string]interface{}{"file": sha3, "sha3-256": sha3, "hash": sha256},
+	})
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+
+	if len(e.Warnings) != 1 || e.Warnings[0].Code != CodeAmbiguous || e.Warnings[0].Path != "attributes.hash" {
+		t.Errorf("expected %s warning, got %v", CodeAmbiguous, e.Warnings)
+	}
+
+	if e.Attributes["hash"] != sha256 {
+		t.Errorf("unexpected attributes %v", e.Attributes)
+	}
+
+	var verr *ValidationError
+	_, errs = ValidateEntity(Entity{
+		Type:       "file",
+		Attributes: map[string]interface{}{"file": md5, "sha1": sha1, "hash": "da39a3ee5e6b4b0d3255bfef95601890afd80709"},
+	})
+	if len(errs) != 1 || !errors.As(errs[0], &verr) || verr.Code != CodeInvalid {
+		t.Errorf("expected %s error, got %v", CodeInvalid, errs)
+	}
+}
+
+func TestValidateObject(t *testing.T) {
+	var validObjects = map[string]string{
+		"FB92636DB83298A4215A2F5FFA2527B1":                                 "fb92636db83298a4215a2f5ffa2527b1",
+		"fb92636d-b832-98a4-215a-2f5ffa2527b1":                             "fb92636d-b832-98a4-215a-2f5ffa2527b1",
+		"21a1610ce915d5d5a8ab5b1f5b6d6715cf4f4e3bc0c868352a175279b1881afe": "21a1610ce915d5d5a8ab5b1f5b6d6715cf4f4e3bc0c868352a175279b1881afe",
+	}
+
+	for in, out := range validObjects {
+		v, _, err := ValidateObject(in)
+		if err != nil || v != out {
+			t.Errorf("%s: expected %s, got %s %v", in, out, v, err)
+		}
+	}
+
+	if _, _, err := ValidateObject("da39a3ee5e6b4b0d3255bfef95601890afd80709"); err == nil {
+		t.Error("a SHA-1 should not be a valid object")
+	}
+}
@@ -2,21 +2,20 @@ package validations
 
 import (
 	"encoding/hex"
-	"fmt"
 	"strings"
 )
 
 func ValidateHexadecimal(value interface{}) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(HEXADECIMAL, value)
 	}
 
 	v = strings.ToLower(v)
 
 	h, err := hex.DecodeString(v)
 	if err != nil {
-		return "", "", err
+		return "", "", wrapValidationError(HEXADECIMAL, CodeInvalid, value, err)
 	}
 
 	hstr := hex.EncodeToString(h)
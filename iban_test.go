@@ -0,0 +1,91 @@
+package validations
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateIBAN(t *testing.T) {
+	var validIBANs = map[string]string{
+		"DE89 3704 0044 0532 0130 00":            "DE89370400440532013000",
+		"gb82 west 1234 5698 7654 32":            "GB82WEST12345698765432",
+		"FR1420041010050500013M02606":            "FR1420041010050500013M02606",
+		"NL91ABNA0417164300":                     "NL91ABNA0417164300",
+		"BE68 5390 0754 7034":                    "BE68539007547034",
+		"NO93 8601 1117 947":                     "NO9386011117947",
+		"MT84 MALT 0110 0001 2345 MTLC AST0 01S": "MT84MALT011000012345MTLCAST001S",
+		"SC18 SSCB 1101 0000 0000 0000 1497 USD": "SC18SSCB11010000000000001497USD",
+		"GB98NWBK60161331926838":                 "GB98NWBK60161331926838",
+		"GB97NWBK60161331926856":                 "GB97NWBK60161331926856",
+		"YE15 CBYE 0001 0188 6123 4567 8912 34":  "YE15CBYE0001018861234567891234",
+	}
+
+	var invalidIBANs = map[string]string{
+		"DE89 3704 0044 0532 0130 01": CodeBadChecksum,
+		"GB01NWBK60161331926838":      CodeBadChecksum,
+		"GB00NWBK60161331926856":      CodeBadChecksum,
+		"GB99NWBK60161331926820":      CodeBadChecksum,
+		"GB82 WEST 1234 5698 7654 3":  CodeRegexMismatch,
+		"DE89 3704 0044 0532 013A 00": CodeRegexMismatch,
+		"US12 3456 7890 1234 5678":    CodeInvalid,
+		"D":                           CodeInvalid,
+	}
+
+	for in, out := range validIBANs {
+		v, _, err := ValidateIBAN(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+		}
+
+		if v != out {
+			t.Errorf("expected %s, got %s", out, v)
+		}
+	}
+
+	for in, code := range invalidIBANs {
+		_, _, err := ValidateIBAN(in)
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Code != code {
+			t.Errorf("%s: expected %s, got %v", in, code, err)
+		}
+	}
+
+	if f := FormatIBAN("DE89370400440532013000"); f != "DE89 3704 0044 0532 0130 00" {
+		t.Errorf("unexpected print format %s", f)
+	}
+}
+
+func TestValidateBIC(t *testing.T) {
+	var validBICs = map[string]string{
+		"DEUTDEFF":    "DEUTDEFF",
+		"deutdeffxxx": "DEUTDEFF",
+		"NEDSZAJJXXX": "NEDSZAJJ",
+		"DABADKKK":    "DABADKKK",
+		"UNCRITMM":    "UNCRITMM",
+		"BNORPHMM100": "BNORPHMM100",
+	}
+
+	var invalidBICs = []string{
+		"DEUTZZFF",
+		"DEUT1EFF",
+		"DEUTDEF",
+		"DEUTDEFF1",
+	}
+
+	for in, out := range validBICs {
+		v, _, err := ValidateBIC(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+		}
+
+		if v != out {
+			t.Errorf("expected %s, got %s", out, v)
+		}
+	}
+
+	for _, in := range invalidBICs {
+		if _, _, err := ValidateBIC(in); err == nil {
+			t.Errorf("%s: this should return an error", in)
+		}
+	}
+}
@@ -12,7 +12,7 @@ func ValidateInteger(value interface{}) (int64, string, error) {
 	}
 	v, ok := value.(int64)
 	if !ok {
-		return 0, "", fmt.Errorf("value is not integer: %v", value)
+		return 0, "", newValidationError(INTEGER, CodeNotInteger, value, "value is not integer: %v", value)
 	}
 
 	return int64(v), GenerateSHA3256(fmt.Sprint(int(v))), nil
@@ -1,41 +1,200 @@
 package validations
 
 import (
-	"fmt"
 	"net"
+	"net/netip"
 	"strings"
 )
 
-func ValidateIP(value interface{}) (string, string, error) {
-	v, ok := value.(string)
+type IPClass string
+
+const (
+	IPPrivate       IPClass = "private"
+	IPLoopback      IPClass = "loopback"
+	IPLinkLocal     IPClass = "link_local"
+	IPMulticast     IPClass = "multicast"
+	IPUnspecified   IPClass = "unspecified"
+	IPDocumentation IPClass = "documentation"
+	IPSharedAddress IPClass = "shared_address"
+	IPBenchmarking  IPClass = "benchmarking"
+	IPReserved      IPClass = "reserved"
+)
+
+// IPPolicy decides which addresses ValidateIP and ValidateCIDR accept. The
+// zero value rejects private, loopback, link local, multicast and unspecified
+// addresses. Custom CIDR lists take precedence over the address classes, and
+// DenyCIDRs over AllowCIDRs.
+type IPPolicy struct {
+	Allow      []IPClass `json:"allow,omitempty"`
+	Deny       []IPClass `json:"deny,omitempty"`
+	AllowCIDRs []string  `json:"allowCidrs,omitempty"`
+	DenyCIDRs  []string  `json:"denyCidrs,omitempty"`
+
+	compiled    bool
+	allow, deny []netip.Prefix
+	err         error
+}
+
+// Compile parses the CIDR lists of the policy so that they are not parsed
+// again for every value. WithIPPolicy compiles the policy it is given, a
+// policy passed to ValidateIP or ValidateCIDR should be compiled beforehand.
+func (p IPPolicy) Compile() (IPPolicy, error) {
+	if p.compiled {
+		return p, p.err
+	}
+
+	p.compiled = true
+	if p.deny, p.err = parsePrefixes(p.DenyCIDRs); p.err == nil {
+		p.allow, p.err = parsePrefixes(p.AllowCIDRs)
+	}
+	return p, p.err
+}
+
+func ipPolicy(policy []IPPolicy) (IPPolicy, error) {
+	if len(policy) == 0 {
+		return IPPolicy{}, nil
+	}
+	return policy[0].Compile()
+}
+
+var ipClassCodes = map[IPClass]string{
+	IPPrivate:       CodePrivateIP,
+	IPLoopback:      CodeLoopbackIP,
+	IPLinkLocal:     CodeLinkLocalIP,
+	IPMulticast:     CodeMulticastIP,
+	IPUnspecified:   CodeUnspecifiedIP,
+	IPDocumentation: CodeDocumentationIP,
+	IPSharedAddress: CodeSharedAddressIP,
+	IPBenchmarking:  CodeBenchmarkingIP,
+	IPReserved:      CodeReservedIP,
+}
+
+var defaultDeniedIPClasses = []IPClass{IPPrivate, IPLoopback, IPLinkLocal, IPMulticast, IPUnspecified}
+
+// ipClasses lists the address classes in the order they are reported.
+var ipClasses = []IPClass{IPPrivate, IPMulticast, IPLinkLocal, IPLoopback, IPUnspecified, IPDocumentation, IPSharedAddress, IPBenchmarking, IPReserved}
+
+var ipClassRanges = map[IPClass][]netip.Prefix{
+	IPPrivate:       mustParsePrefixes("10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7"),
+	IPMulticast:     mustParsePrefixes("224.0.0.0/4", "ff00::/8"),
+	IPLinkLocal:     mustParsePrefixes("169.254.0.0/16", "fe80::/10"),
+	IPLoopback:      mustParsePrefixes("127.0.0.0/8", "::1/128"),
+	IPUnspecified:   mustParsePrefixes("0.0.0.0/32", "::/128"),
+	IPDocumentation: mustParsePrefixes("192.0.2.0/24", "198.51.100.0/24", "203.0.113.0/24", "2001:db8::/32", "3fff::/20"),
+	IPSharedAddress: mustParsePrefixes("100.64.0.0/10"),
+	IPBenchmarking:  mustParsePrefixes("198.18.0.0/15", "2001:2::/48"),
+	IPReserved:      mustParsePrefixes("0.0.0.0/8", "192.0.0.0/24", "192.88.99.0/24", "240.0.0.0/4", "100::/64", "2001:10::/28"),
+}
+
+func mustParsePrefixes(cidrs ...string) []netip.Prefix {
+	prefixes, err := parsePrefixes(cidrs)
+	if err != nil {
+		panic(err)
+	}
+	return prefixes
+}
+
+// IPClasses returns the special purpose classes the address belongs to.
+func IPClasses(addr net.IP) []IPClass {
+	ip, ok := netip.AddrFromSlice(addr)
 	if !ok {
-		return "", "", fmt.Errorf("value not string: %v", value)
+		return nil
 	}
+	ip = ip.Unmap()
+	return prefixClasses(netip.PrefixFrom(ip, ip.BitLen()))
+}
 
-	addr := net.ParseIP(strings.ToLower(v))
-	if addr == nil {
-		return "", "", fmt.Errorf("invalid IP: %s", v)
+// prefixClasses returns the special purpose classes overlapping a network.
+func prefixClasses(prefix netip.Prefix) []IPClass {
+	var classes []IPClass
+	for _, class := range ipClasses {
+		if overlapsPrefix(ipClassRanges[class], prefix) {
+			classes = append(classes, class)
+		}
 	}
-	if addr.IsPrivate() {
-		return "", "", fmt.Errorf("cannot accept private IP: %s", v)
+	return classes
+}
+
+func overlapsPrefix(prefixes []netip.Prefix, prefix netip.Prefix) bool {
+	for _, p := range prefixes {
+		if p.Overlaps(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasIPClass(classes []IPClass, class IPClass) bool {
+	for _, c := range classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func (p IPPolicy) denies(class IPClass) bool {
+	if hasIPClass(p.Deny, class) {
+		return true
+	}
+	return hasIPClass(defaultDeniedIPClasses, class) && !hasIPClass(p.Allow, class)
+}
+
+// check applies the policy to an address, or to a network given as a
+// prefix. A network is denied when it overlaps a denied CIDR or class and
+// allowed when an allowed CIDR holds all of it.
+func (p IPPolicy) check(prefix netip.Prefix, value interface{}) error {
+	if overlapsPrefix(p.deny, prefix) {
+		return newValidationError(IP, CodeDeniedIP, value, "cannot accept denied IP: %v", value)
+	}
+
+	for _, a := range p.allow {
+		if a.Bits() <= prefix.Bits() && a.Contains(prefix.Addr()) {
+			return nil
+		}
 	}
-	if addr.IsInterfaceLocalMulticast() {
-		return "", "", fmt.Errorf("cannot accept interface local multicast IP: %s", v)
+
+	for _, class := range prefixClasses(prefix) {
+		if p.denies(class) {
+			return newValidationError(IP, ipClassCodes[class], value, "cannot accept %s IP: %v", strings.ReplaceAll(string(class), "_", " "), value)
+		}
 	}
-	if addr.IsLinkLocalMulticast() {
-		return "", "", fmt.Errorf("cannot accept link local multicast IP: %s", v)
+
+	return nil
+}
+
+func parsePrefixes(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, c := range cidrs {
+		prefix, err := netip.ParsePrefix(c)
+		if err != nil {
+			return nil, newValidationError(IP, CodeInvalidPolicy, c, "invalid CIDR in IP policy: %s", c)
+		}
+		prefixes = append(prefixes, prefix.Masked())
 	}
-	if addr.IsLinkLocalUnicast() {
-		return "", "", fmt.Errorf("cannot accept link local unicast IP: %s", v)
+	return prefixes, nil
+}
+
+func ValidateIP(value interface{}, policy ...IPPolicy) (string, string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return "", "", errNotString(IP, value)
 	}
-	if addr.IsLoopback() {
-		return "", "", fmt.Errorf("cannot accept loopback IP: %s", v)
+
+	addr := net.ParseIP(strings.ToLower(v))
+	if addr == nil {
+		return "", "", newValidationError(IP, CodeInvalid, value, "invalid IP: %s", v)
 	}
-	if addr.IsMulticast() {
-		return "", "", fmt.Errorf("cannot accept multicast IP: %s", v)
+
+	p, err := ipPolicy(policy)
+	if err != nil {
+		return "", "", err
 	}
-	if addr.IsUnspecified() {
-		return "", "", fmt.Errorf("cannot accept unspecified IP: %s", v)
+
+	ip, _ := netip.AddrFromSlice(addr)
+	ip = ip.Unmap()
+	if err := p.check(netip.PrefixFrom(ip, ip.BitLen()), value); err != nil {
+		return "", "", err
 	}
 
 	a := addr.String()
@@ -0,0 +1,94 @@
+package validations
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateIP(t *testing.T) {
+	var cases = []struct {
+		ip     string
+		policy IPPolicy
+		code   string
+	}{
+		{"8.8.8.8", IPPolicy{}, ""},
+		{"2001:4860:4860::8888", IPPolicy{}, ""},
+		{"10.1.2.3", IPPolicy{}, CodePrivateIP},
+		{"10.1.2.3", IPPolicy{Allow: []IPClass{IPPrivate}}, ""},
+		{"fd00::1", IPPolicy{Allow: []IPClass{IPPrivate}}, ""},
+		{"127.0.0.1", IPPolicy{Allow: []IPClass{IPPrivate}}, CodeLoopbackIP},
+		{"fe80::1", IPPolicy{}, CodeLinkLocalIP},
+		{"ff02::1", IPPolicy{}, CodeMulticastIP},
+		{"::", IPPolicy{}, CodeUnspecifiedIP},
+		{"192.0.2.10", IPPolicy{}, ""},
+		{"192.0.2.10", IPPolicy{Deny: []IPClass{IPDocumentation}}, CodeDocumentationIP},
+		{"2001:db8::1", IPPolicy{Deny: []IPClass{IPDocumentation}}, CodeDocumentationIP},
+		{"100.64.1.1", IPPolicy{Deny: []IPClass{IPSharedAddress}}, CodeSharedAddressIP},
+		{"198.19.0.1", IPPolicy{Deny: []IPClass{IPBenchmarking}}, CodeBenchmarkingIP},
+		{"255.255.255.255", IPPolicy{Deny: []IPClass{IPReserved}}, CodeReservedIP},
+		{"172.16.5.4", IPPolicy{AllowCIDRs: []string{"172.16.0.0/16"}}, ""},
+		{"172.16.5.4", IPPolicy{AllowCIDRs: []string{"172.16.0.0/12"}, DenyCIDRs: []string{"172.16.5.0/24"}}, CodeDeniedIP},
+		{"8.8.8.8", IPPolicy{DenyCIDRs: []string{"8.8.8.0/24"}}, CodeDeniedIP},
+		{"8.8.8.8", IPPolicy{DenyCIDRs: []string{"8.8.8.0/33"}}, CodeInvalidPolicy},
+	}
+
+	for _, c := range cases {
+		_, _, err := ValidateIP(c.ip, c.policy)
+		if c.code == "" {
+			if err != nil {
+				t.Errorf("%s: %v", c.ip, err)
+			}
+			continue
+		}
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Code != c.code {
+			t.Errorf("%s: expected %s, got %v", c.ip, c.code, err)
+		}
+	}
+
+	policy := IPPolicy{Allow: []IPClass{IPPrivate}}
+	if _, _, err := ValidateValue("192.168.0.0/16", "cidr", WithIPPolicy(policy)); err != nil {
+		t.Error(err)
+	}
+
+	var cidrCases = []struct {
+		cidr   string
+		policy IPPolicy
+		code   string
+	}{
+		{"8.8.8.0/24", IPPolicy{}, ""},
+		{"8.0.0.0/5", IPPolicy{}, CodePrivateIP},
+		{"8.8.8.8/4", IPPolicy{}, CodePrivateIP},
+		{"8.8.8.8/4", IPPolicy{Allow: []IPClass{IPPrivate}}, CodeUnspecifiedIP},
+		{"8.8.8.8/4", IPPolicy{Allow: []IPClass{IPPrivate, IPUnspecified}}, ""},
+		{"8.0.0.0/8", IPPolicy{DenyCIDRs: []string{"8.8.8.0/24"}}, CodeDeniedIP},
+		{"0.0.0.0/0", IPPolicy{Allow: []IPClass{IPUnspecified, IPReserved}, DenyCIDRs: []string{"8.8.8.0/24"}}, CodeDeniedIP},
+		{"172.16.5.0/24", IPPolicy{AllowCIDRs: []string{"172.16.0.0/12"}}, ""},
+		{"172.0.0.0/8", IPPolicy{AllowCIDRs: []string{"172.16.0.0/12"}, DenyCIDRs: []string{"172.0.0.0/16"}}, CodeDeniedIP},
+		{"8.8.8.0/24", IPPolicy{DenyCIDRs: []string{"8.8.8.0/33"}}, CodeInvalidPolicy},
+	}
+
+	for _, c := range cidrCases {
+		_, _, err := ValidateValue(c.cidr, "cidr", WithIPPolicy(c.policy))
+		if c.code == "" {
+			if err != nil {
+				t.Errorf("%s: %v", c.cidr, err)
+			}
+			continue
+		}
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Code != c.code {
+			t.Errorf("%s: expected %s, got %v", c.cidr, c.code, err)
+		}
+	}
+
+	_, errs := ValidateEntity(Entity{
+		Type:       "ip",
+		Attributes: map[string]interface{}{"ip": "10.0.0.1", "cidr": "10.0.0.0/8"},
+	}, WithIPPolicy(policy))
+	for _, err := range errs {
+		t.Error(err)
+	}
+}
@@ -1,22 +1,185 @@
 package validations
 
 import (
+	"bufio"
+	_ "embed"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"strings"
+	"sync"
 )
 
+// ouiData is the embedded OUI registry, see oui.txt for its source.
+//
+//go:generate curl -sSfL -o oui.txt https://standards-oui.ieee.org/oui/oui.txt
+//go:embed oui.txt
+var ouiData string
+
+// MACAddress is an EUI-48 or EUI-64 hardware address in its canonical form,
+// upper case hexadecimal octets separated by dashes.
+type MACAddress struct {
+	Address             string `json:"address"`
+	EUI64               bool   `json:"eui64"`
+	Multicast           bool   `json:"multicast"`
+	LocallyAdministered bool   `json:"locallyAdministered"`
+	Vendor              string `json:"vendor,omitempty"`
+}
+
+var (
+	ouiMu       sync.RWMutex
+	ouiRegistry map[string]string
+)
+
+func init() {
+	r, err := ParseOUIRegistry(strings.NewReader(ouiData))
+	if err != nil {
+		panic(err)
+	}
+	ouiRegistry = r
+}
+
+// ParseOUIRegistry parses the "(hex)" lines of the IEEE oui.txt file,
+// returning the vendors by canonical OUI, e.g. 00-00-0C.
+func ParseOUIRegistry(r io.Reader) (map[string]string, error) {
+	registry := map[string]string{}
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		oui, vendor, ok := strings.Cut(s.Text(), "(hex)")
+		if !ok {
+			continue
+		}
+
+		oui = strings.ToUpper(strings.TrimSpace(oui))
+		if len(oui) != 8 {
+			return nil, fmt.Errorf("invalid OUI: %s", oui)
+		}
+		registry[oui] = strings.TrimSpace(vendor)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+// LoadOUIRegistry replaces the embedded OUI registry. The embedded subset
+// only knows common vendors, so long running services should download the
+// full registry from https://standards-oui.ieee.org/oui/oui.txt, which the
+// IEEE updates daily, and load it here.
+func LoadOUIRegistry(r io.Reader) error {
+	registry, err := ParseOUIRegistry(r)
+	if err != nil {
+		return err
+	}
+
+	ouiMu.Lock()
+	defer ouiMu.Unlock()
+
+	ouiRegistry = registry
+	return nil
+}
+
+// LookupOUI returns the vendor of a MAC address. Locally administered
+// addresses have no vendor.
+func LookupOUI(mac string) (string, bool) {
+	m, err := ParseMAC(mac)
+	if err != nil || m.Vendor == "" {
+		return "", false
+	}
+	return m.Vendor, true
+}
+
+// ParseMAC parses an EUI-48 or EUI-64 address written with colons or dashes
+// between octets (00:1b:63:84:45:e6), in Cisco dotted notation
+// (001b.6384.45e6), split in two halves (001b63-8445e6) or as bare hex.
+func ParseMAC(s string) (MACAddress, error) {
+	v := strings.TrimSpace(s)
+
+	var groups []string
+	switch sep := strings.IndexAny(v, ":-."); {
+	case sep < 0:
+		groups = []string{v}
+	default:
+		groups = strings.Split(v, v[sep:sep+1])
+	}
+
+	digits := strings.Join(groups, "")
+	if len(digits) != 12 && len(digits) != 16 {
+		return MACAddress{}, newValidationError(MAC, CodeInvalid, s, "invalid MAC address: %s", s)
+	}
+
+	if len(groups) > 1 {
+		size := len(groups[0])
+		for _, g := range groups {
+			if len(g) != size {
+				return MACAddress{}, newValidationError(MAC, CodeInvalid, s, "invalid MAC address: %s", s)
+			}
+		}
+
+		if size != 2 && size != 4 && len(groups) != 2 {
+			return MACAddress{}, newValidationError(MAC, CodeInvalid, s, "invalid MAC address: %s", s)
+		}
+	}
+
+	b, err := hex.DecodeString(digits)
+	if err != nil {
+		return MACAddress{}, newValidationError(MAC, CodeInvalid, s, "invalid MAC address: %s", s)
+	}
+
+	octets := make([]string, len(b))
+	for i, o := range b {
+		octets[i] = fmt.Sprintf("%02X", o)
+	}
+
+	m := MACAddress{
+		Address:             strings.Join(octets, "-"),
+		EUI64:               len(b) == 8,
+		Multicast:           b[0]&0x01 != 0,
+		LocallyAdministered: b[0]&0x02 != 0,
+	}
+
+	if !m.LocallyAdministered {
+		// Group addresses belong to the organization of the OUI too.
+		oui := fmt.Sprintf("%02X-%02X-%02X", b[0]&^0x01, b[1], b[2])
+
+		ouiMu.RLock()
+		m.Vendor = ouiRegistry[oui]
+		ouiMu.RUnlock()
+	}
+
+	return m, nil
+}
+
 func ValidateMAC(value interface{}) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(MAC, value)
+	}
+
+	m, err := ParseMAC(v)
+	if err != nil {
+		return "", "", err
 	}
 
-	v = strings.ToUpper(v)
+	return m.Address, GenerateSHA3256(m.Address), nil
+}
+
+// macVendorHook fills the vendor attribute of MAC addresses registered in
+// the OUI registry.
+func macVendorHook(entity *Entity) []error {
+	if _, ok := entity.Attributes[macVendor.Type]; ok {
+		return nil
+	}
 
-	e := ValidateRegEx(`^([0-9A-F]{2,2}[-]){5,5}([0-9A-F]{2,2})$`, v)
-	if e != nil {
-		return "", "", e
+	mac, ok := entity.Attributes[entity.Type].(string)
+	if !ok {
+		return nil
 	}
 
-	return v, GenerateSHA3256(v), nil
+	if vendor, ok := LookupOUI(mac); ok {
+		entity.Attributes[macVendor.Type] = strings.ToLower(vendor)
+	}
+	return nil
 }
@@ -0,0 +1,109 @@
+package validations
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateMAC(t *testing.T) {
+	var validMACs = map[string]string{
+		"00-1B-63-84-45-E6":       "00-1B-63-84-45-E6",
+		"00-1b-63-84-45-e6":       "00-1B-63-84-45-E6",
+		"00:1b:63:84:45:e6":       "00-1B-63-84-45-E6",
+		"001b.6384.45e6":          "00-1B-63-84-45-E6",
+		"001b63-8445e6":           "00-1B-63-84-45-E6",
+		"001B638445E6":            "00-1B-63-84-45-E6",
+		"02:42:ac:11:00:02":       "02-42-AC-11-00-02",
+		"00:1b:63:ff:fe:84:45:e6": "00-1B-63-FF-FE-84-45-E6",
+		"001b.63ff.fe84.45e6":     "00-1B-63-FF-FE-84-45-E6",
+	}
+
+	var invalidMACs = []string{
+		"",
+		"00-1B-63-84-45",
+		"00:1b-63:84-45:e6",
+		"0:1b:63:84:45:e6:0",
+		"00-1B-63-84-45-G6",
+		"001b6.38445e6",
+		"00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01",
+	}
+
+	for in, out := range validMACs {
+		v, id, err := ValidateMAC(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+		}
+
+		if v != out || id != GenerateSHA3256(out) {
+			t.Errorf("%s: expected %s, got %s", in, out, v)
+		}
+	}
+
+	for _, in := range invalidMACs {
+		if _, _, err := ValidateMAC(in); err == nil {
+			t.Errorf("%s: this should return an error", in)
+		}
+	}
+}
+
+func TestParseMAC(t *testing.T) {
+	var cases = map[string]MACAddress{
+		"00:1b:63:84:45:e6":       {Address: "00-1B-63-84-45-E6", Vendor: "Apple, Inc."},
+		"02:42:ac:11:00:02":       {Address: "02-42-AC-11-00-02", LocallyAdministered: true},
+		"01:00:5e:00:00:fb":       {Address: "01-00-5E-00-00-FB", Multicast: true, Vendor: "ICANN, IANA Department"},
+		"ff:ff:ff:ff:ff:ff":       {Address: "FF-FF-FF-FF-FF-FF", Multicast: true, LocallyAdministered: true},
+		"00:50:56:ff:fe:00:00:01": {Address: "00-50-56-FF-FE-00-00-01", EUI64: true, Vendor: "VMware, Inc."},
+	}
+
+	for in, out := range cases {
+		m, err := ParseMAC(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+
+		if m != out {
+			t.Errorf("%s: expected %+v, got %+v", in, out, m)
+		}
+	}
+}
+
+func TestMACVendor(t *testing.T) {
+	e, errs := ValidateEntity(Entity{
+		Type:       "mac-address",
+		Attributes: map[string]interface{}{"mac-address": "b8:27:eb:12:34:56"},
+	})
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+
+	if e.Attributes["mac-vendor"] != "raspberry pi foundation" {
+		t.Errorf("unexpected vendor %v", e.Attributes["mac-vendor"])
+	}
+
+	defer func() {
+		if err := LoadOUIRegistry(strings.NewReader(ouiData)); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	registry := "OUI/MA-L                                                    Organization                                 \r\n" +
+		"company_id                                                  Organization                                 \r\n" +
+		"                                                            Address                                      \r\n" +
+		"\r\n" +
+		"AC-DE-48   (hex)\t\tExample Corp\r\n" +
+		"ACDE48     (base 16)\t\tExample Corp\r\n" +
+		"\t\t\t\tSomewhere  CA  94000\r\n" +
+		"\t\t\t\tUS\r\n"
+	if err := LoadOUIRegistry(strings.NewReader(registry)); err != nil {
+		t.Fatal(err)
+	}
+
+	if vendor, ok := LookupOUI("ac:de:48:12:34:56"); !ok || vendor != "Example Corp" {
+		t.Errorf("unexpected vendor %s", vendor)
+	}
+
+	if _, ok := LookupOUI("b8:27:eb:12:34:56"); ok {
+		t.Error("vendor should not be found in the loaded registry")
+	}
+}
@@ -1,25 +1,24 @@
 package validations
 
 import (
-	"fmt"
 	"strings"
 )
 
 func ValidateMD5(value interface{}) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(MD5, value)
 	}
 
 	v = strings.ToLower(v)
 
 	if len([]rune(v)) == 32 {
-		e := ValidateRegEx(`^[0-9a-f]{32}$`, v)
+		e := matchRegEx(MD5, hex32Regex, v)
 		if e != nil {
 			return "", "", e
 		}
 	} else {
-		e := ValidateRegEx(`^[0-9a-f]{16}$`, v)
+		e := matchRegEx(MD5, hex16Regex, v)
 		if e != nil {
 			return "", "", e
 		}
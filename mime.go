@@ -1,22 +1,277 @@
 package validations
 
 import (
-	"fmt"
+	"bufio"
+	_ "embed"
+	"io"
+	"mime"
 	"strings"
+	"sync"
 )
 
+// mimeTypesData is the mime.types file of the Debian media-types package,
+// only used to look up the media type of file extensions.
+//
+//go:embed mime.types
+var mimeTypesData string
+
+// ianaMediaTypesData lists the media types of the IANA registry, one per
+// line.
+//
+//go:embed iana-media-types.txt
+var ianaMediaTypesData string
+
+// MediaType is a media type as defined by RFC 6838, e.g.
+// text/plain; charset=utf-8.
+type MediaType struct {
+	Type       string            `json:"type"`
+	Subtype    string            `json:"subtype"`
+	Suffix     string            `json:"suffix,omitempty"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+	Registered bool              `json:"registered"`
+}
+
+var topLevelMediaTypes = map[string]bool{
+	"application": true,
+	"audio":       true,
+	"example":     true,
+	"font":        true,
+	"haptics":     true,
+	"image":       true,
+	"message":     true,
+	"model":       true,
+	"multipart":   true,
+	"text":        true,
+	"video":       true,
+}
+
+var (
+	mediaTypesMu         sync.RWMutex
+	registeredMediaTypes map[string]bool
+	mediaTypesByExts     map[string]string
+)
+
+func init() {
+	types, err := parseMediaTypeRegistry(strings.NewReader(ianaMediaTypesData))
+	if err != nil {
+		panic(err)
+	}
+
+	exts, err := parseMediaTypes(strings.NewReader(mimeTypesData))
+	if err != nil {
+		panic(err)
+	}
+	registeredMediaTypes, mediaTypesByExts = types, exts
+}
+
+// parseMediaTypeRegistry parses a list of media types, one per line.
+func parseMediaTypeRegistry(r io.Reader) (map[string]bool, error) {
+	types := map[string]bool{}
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		t := strings.ToLower(strings.TrimSpace(line))
+		if t == "" {
+			continue
+		}
+
+		if _, _, ok := strings.Cut(t, "/"); !ok {
+			return nil, newValidationError(MIME, CodeInvalid, t, "invalid media type: %s", t)
+		}
+		types[t] = true
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return types, nil
+}
+
+// parseMediaTypes parses a mime.types file, with one media type per line
+// followed by its file extensions. Commented out types are ignored.
+func parseMediaTypes(r io.Reader) (map[string]string, error) {
+	exts := map[string]string{}
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		t := strings.ToLower(fields[0])
+		top, _, ok := strings.Cut(t, "/")
+		if !ok {
+			return nil, newValidationError(MIME, CodeInvalid, t, "invalid media type: %s", t)
+		}
+
+		// Unofficial top-level types, e.g. chemical or inode, are not
+		// valid media types.
+		if !topLevelMediaTypes[top] {
+			continue
+		}
+
+		for _, ext := range fields[1:] {
+			ext = strings.ToLower(ext)
+			if _, ok := exts[ext]; !ok {
+				exts[ext] = t
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return exts, nil
+}
+
+// LoadMediaTypeRegistry replaces the embedded IANA media types with a list
+// of media types, one per line, e.g. generated from a newer registry.
+func LoadMediaTypeRegistry(r io.Reader) error {
+	types, err := parseMediaTypeRegistry(r)
+	if err != nil {
+		return err
+	}
+
+	mediaTypesMu.Lock()
+	defer mediaTypesMu.Unlock()
+
+	registeredMediaTypes = types
+	return nil
+}
+
+// LoadMediaTypes replaces the embedded file extensions with the ones of a
+// mime.types file.
+func LoadMediaTypes(r io.Reader) error {
+	exts, err := parseMediaTypes(r)
+	if err != nil {
+		return err
+	}
+
+	mediaTypesMu.Lock()
+	defer mediaTypesMu.Unlock()
+
+	mediaTypesByExts = exts
+	return nil
+}
+
+// MediaTypeByExtension returns the media type of a file extension, with or
+// without the leading dot.
+func MediaTypeByExtension(ext string) (string, bool) {
+	ext = strings.ToLower(strings.TrimPrefix(ext, "."))
+
+	mediaTypesMu.RLock()
+	defer mediaTypesMu.RUnlock()
+
+	t, ok := mediaTypesByExts[ext]
+	return t, ok
+}
+
+// ParseMediaType parses a media type and its parameters. Type, subtype and
+// parameter names are lower cased, as is the charset value.
+func ParseMediaType(s string) (MediaType, error) {
+	v := strings.TrimSpace(s)
+
+	full, params, err := mime.ParseMediaType(v)
+	if err != nil {
+		return MediaType{}, wrapValidationError(MIME, CodeInvalid, s, err)
+	}
+
+	t, sub, _ := strings.Cut(full, "/")
+	if !topLevelMediaTypes[t] {
+		return MediaType{}, newValidationError(MIME, CodeInvalid, s, "unknown top-level media type: %s", t)
+	}
+
+	if !isRestrictedName(sub) {
+		return MediaType{}, newValidationError(MIME, CodeInvalid, s, "invalid media subtype: %s", sub)
+	}
+
+	m := MediaType{Type: t, Subtype: sub}
+
+	if i := strings.LastIndexByte(sub, '+'); i > 0 {
+		m.Suffix = sub[i+1:]
+	}
+
+	if len(params) > 0 {
+		m.Parameters = params
+		if cs, ok := params["charset"]; ok {
+			params["charset"] = strings.ToLower(cs)
+		}
+	}
+
+	mediaTypesMu.RLock()
+	m.Registered = registeredMediaTypes[full]
+	mediaTypesMu.RUnlock()
+
+	return m, nil
+}
+
+// String returns the media type with its parameters sorted by name.
+func (m MediaType) String() string {
+	return mime.FormatMediaType(m.Type+"/"+m.Subtype, m.Parameters)
+}
+
+// isRestrictedName reports whether s is a restricted-name of RFC 6838.
+func isRestrictedName(s string) bool {
+	if s == "" || len(s) > 127 {
+		return false
+	}
+
+	for i, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case i > 0 && strings.ContainsRune("!#$&-^_.+", c):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 func ValidateMime(value interface{}) (string, string, error) {
+	return validateMime(value, Options{})
+}
+
+func validateMime(value interface{}, opts Options) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(MIME, value)
 	}
 
-	v = strings.ToLower(v)
+	m, err := ParseMediaType(v)
+	if err != nil {
+		return "", "", err
+	}
 
-	e := ValidateRegEx(`^([a-z]+)[/]([a-z]+[a-z+-.][a-z]+)+$`, v)
-	if e != nil {
-		return "", "", e
+	if opts.RegisteredMediaTypes && !m.Registered {
+		return "", "", newValidationError(MIME, CodeUnregistered, v, "unregistered media type: %s/%s", m.Type, m.Subtype)
 	}
 
-	return v, GenerateSHA3256(v), nil
+	s := m.String()
+	return s, GenerateSHA3256(s), nil
+}
+
+// filenameMimeHook fills the media type of files from their extension.
+func filenameMimeHook(entity *Entity) []error {
+	if _, ok := entity.Attributes[mimeType.Type]; ok {
+		return nil
+	}
+
+	name, ok := entity.Attributes[entity.Type].(string)
+	if !ok {
+		return nil
+	}
+
+	name = name[strings.LastIndexAny(name, `/\`)+1:]
+	i := strings.LastIndexByte(name, '.')
+	if i <= 0 {
+		return nil
+	}
+
+	if t, ok := MediaTypeByExtension(name[i+1:]); ok {
+		entity.Attributes[mimeType.Type] = t
+	}
+	return nil
 }
@@ -0,0 +1,175 @@
+package validations
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateMime(t *testing.T) {
+	var validMimes = map[string]string{
+		"text/plain":      "text/plain",
+		"Application/PDF": "application/pdf",
+		"application/vnd.ms-excel.sheet.macroEnabled.12": "application/vnd.ms-excel.sheet.macroenabled.12",
+		"text/x-c++; charset=UTF-8":                      "text/x-c++; charset=utf-8",
+		"text/html;charset=\"ISO-8859-1\"":               "text/html; charset=iso-8859-1",
+		"multipart/form-data; boundary=x; Charset=utf-8": "multipart/form-data; boundary=x; charset=utf-8",
+		"image/svg+xml": "image/svg+xml",
+		"application/x": "application/x",
+	}
+
+	var invalidMimes = []string{
+		"",
+		"text",
+		"text/",
+		"/plain",
+		"foo/bar",
+		"x-custom/type",
+		"text/.plain",
+		"text/plain; charset",
+		"text/plain/html",
+	}
+
+	for in, out := range validMimes {
+		v, id, err := ValidateMime(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+
+		if v != out || id != GenerateSHA3256(out) {
+			t.Errorf("%s: expected %s, got %s", in, out, v)
+		}
+	}
+
+	for _, in := range invalidMimes {
+		if _, _, err := ValidateMime(in); err == nil {
+			t.Errorf("%s: this should return an error", in)
+		}
+	}
+}
+
+func TestParseMediaType(t *testing.T) {
+	m, err := ParseMediaType("application/ld+json; profile=\"http://www.w3.org/ns/json-ld#compacted\"")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Type != "application" || m.Subtype != "ld+json" || m.Suffix != "json" || !m.Registered {
+		t.Errorf("unexpected media type %+v", m)
+	}
+
+	if m.Parameters["profile"] != "http://www.w3.org/ns/json-ld#compacted" {
+		t.Errorf("unexpected parameters %v", m.Parameters)
+	}
+
+	for _, in := range []string{"application/made-up", "text/x.custom", "text/x-csrc", "application/x-msdownload"} {
+		m, err := ParseMediaType(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+		}
+
+		if m.Registered {
+			t.Errorf("%s: should not be registered", in)
+		}
+	}
+}
+
+func TestRegisteredMediaTypes(t *testing.T) {
+	for _, in := range []string{"application/pdf", "application/vnd.ms-excel.sheet.macroEnabled.12", "text/plain; charset=utf-8"} {
+		if _, _, err := ValidateValue(in, "mime-type", WithRegisteredMediaTypes()); err != nil {
+			t.Errorf("%s: %v", in, err)
+		}
+	}
+
+	var verr *ValidationError
+	for _, in := range []string{"application/made-up", "text/x-csrc"} {
+		_, _, err := ValidateValue(in, "mime-type", WithRegisteredMediaTypes())
+		if !errors.As(err, &verr) || verr.Code != CodeUnregistered {
+			t.Errorf("%s: expected %s error, got %v", in, CodeUnregistered, err)
+		}
+
+		if _, _, err := ValidateValue(in, "mime-type"); err != nil {
+			t.Errorf("%s: %v", in, err)
+		}
+	}
+
+	defer func() {
+		if err := LoadMediaTypeRegistry(strings.NewReader(ianaMediaTypesData)); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	if err := LoadMediaTypeRegistry(strings.NewReader("# comment\napplication/made-up\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := ValidateValue("application/made-up", "mime-type", WithRegisteredMediaTypes()); err != nil {
+		t.Error(err)
+	}
+
+	if _, _, err := ValidateValue("application/pdf", "mime-type", WithRegisteredMediaTypes()); err == nil {
+		t.Error("media type should not be found in the loaded registry")
+	}
+}
+
+func TestLoadMediaTypes(t *testing.T) {
+	defer func() {
+		if err := LoadMediaTypes(strings.NewReader(mimeTypesData)); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	if err := LoadMediaTypes(strings.NewReader("application/made-up\tmup\nchemical/x-pdb\tpdb\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, ok := MediaTypeByExtension("mup"); !ok || v != "application/made-up" {
+		t.Errorf("unexpected media type %s", v)
+	}
+
+	if _, ok := MediaTypeByExtension("pdb"); ok {
+		t.Error("unofficial top-level types should be skipped")
+	}
+
+	if _, ok := MediaTypeByExtension("pdf"); ok {
+		t.Error("extension should not be found in the loaded media types")
+	}
+}
+
+func TestFilenameMime(t *testing.T) {
+	var cases = map[string]string{
+		"invoice.PDF":                 "application/pdf",
+		`C:\Users\Public\report.xlsm`: "application/vnd.ms-excel.sheet.macroenabled.12",
+		"archive.tar.gz":              "application/gzip",
+	}
+
+	for in, out := range cases {
+		e, errs := ValidateEntity(Entity{
+			Type:       "filename",
+			Attributes: map[string]interface{}{"filename": in},
+		})
+		if len(errs) != 0 {
+			t.Errorf("%s: %v", in, errs)
+			continue
+		}
+
+		if e.Attributes["mime-type"] != out {
+			t.Errorf("%s: expected %s, got %v", in, out, e.Attributes["mime-type"])
+		}
+	}
+
+	for _, in := range []string{"README", ".bashrc", "dir.d/file"} {
+		e, errs := ValidateEntity(Entity{
+			Type:       "filename",
+			Attributes: map[string]interface{}{"filename": in},
+		})
+		if len(errs) != 0 {
+			t.Errorf("%s: %v", in, errs)
+		}
+
+		if _, ok := e.Attributes["mime-type"]; ok {
+			t.Errorf("%s: unexpected media type %v", in, e.Attributes["mime-type"])
+		}
+	}
+}
@@ -1,20 +1,24 @@
 package validations
 
-import "fmt"
-
+// ValidateObject accepts a UUID, an MD5 or a SHA3-256 checksum. Hexadecimal
+// digests are detected by format first, so a bare 32 digit value is an MD5
+// and UUIDs have to be written with dashes.
 func ValidateObject(value interface{}) (string, string, error) {
-	s1, h1, err := ValidateUUID(value)
-	if err == nil {
-		return s1.String(), h1, nil
+	if v, ok := value.(string); ok {
+		for _, t := range DetectHash(v) {
+			switch t {
+			case hashMD5.Type:
+				return validateMD5Digest(v)
+			case hashSHA3256.Type:
+				return ValidateSHA3256(v)
+			}
+		}
 	}
-	s2, h2, err := ValidateMD5(value)
-	if err == nil {
-		return s2, h2, nil
-	}
-	s3, h3, err := ValidateSHA3256(value)
+
+	u, h, err := ValidateUUID(value)
 	if err == nil {
-		return s3, h3, nil
+		return u.String(), h, nil
 	}
 
-	return "", "", fmt.Errorf("invalid object: %v", value)
+	return "", "", newValidationError(OBJECT, CodeInvalid, value, "invalid object: %v", value)
 }
@@ -0,0 +1,162 @@
+package validations
+
+// Options holds the settings used while validating a value. The zero value
+// keeps the default behavior of every validator.
+type Options struct {
+	IPPolicy             IPPolicy
+	MaskCardNumber       bool
+	UnicodeFQDN          bool
+	RejectMixedScript    bool
+	RegistrableDomain    bool
+	URLNormalization     URLNormalization
+	Refang               bool
+	PhoneRegion          string
+	CountryFormat        CountryFormat
+	KnownCities          bool
+	DatetimeLayouts      []string
+	TimeBounds           TimeBounds
+	RegisteredMediaTypes bool
+}
+
+type Option func(*Options)
+
+func WithIPPolicy(policy IPPolicy) Option {
+	// An invalid CIDR is kept in the policy and reported by the validators.
+	policy, _ = policy.Compile()
+	return func(o *Options) {
+		o.IPPolicy = policy
+	}
+}
+
+// WithCardNumberMasking makes card numbers normalize to their masked form,
+// with an ID derived from the masked form so the full number can not be
+// recovered from the stored values.
+func WithCardNumberMasking() Option {
+	return func(o *Options) {
+		o.MaskCardNumber = true
+	}
+}
+
+// WithUnicodeFQDN makes domain names normalize to their U-label form. The
+// ID is still computed from the A-label form.
+func WithUnicodeFQDN() Option {
+	return func(o *Options) {
+		o.UnicodeFQDN = true
+	}
+}
+
+// WithMixedScriptRejection rejects domain names having a label that mixes
+// scripts.
+func WithMixedScriptRejection() Option {
+	return func(o *Options) {
+		o.RejectMixedScript = true
+	}
+}
+
+// WithRegistrableDomain makes the domain type accept only registrable
+// domains, rejecting public suffixes and subdomains.
+func WithRegistrableDomain() Option {
+	return func(o *Options) {
+		o.RegistrableDomain = true
+	}
+}
+
+func WithURLNormalization(n URLNormalization) Option {
+	return func(o *Options) {
+		o.URLNormalization = n
+	}
+}
+
+// WithRefang refangs string values, e.g. hxxp://evil[.]com, before they are
+// validated.
+func WithRefang() Option {
+	return func(o *Options) {
+		o.Refang = true
+	}
+}
+
+// WithPhoneRegion accepts phone numbers in the national format of region,
+// an ISO 3166 alpha-2 code.
+func WithPhoneRegion(region string) Option {
+	return func(o *Options) {
+		o.PhoneRegion = region
+	}
+}
+
+// WithCountryFormat selects the form countries normalize to. The ID is
+// always computed from the alpha-2 code.
+func WithCountryFormat(f CountryFormat) Option {
+	return func(o *Options) {
+		o.CountryFormat = f
+	}
+}
+
+// WithKnownCities rejects the cities missing from the gazetteer, or from
+// the country given on the same entity. The embedded gazetteer only holds
+// major cities, see LoadGazetteer.
+func WithKnownCities() Option {
+	return func(o *Options) {
+		o.KnownCities = true
+	}
+}
+
+// WithDatetimeLayouts replaces the layouts datetimes are parsed with.
+func WithDatetimeLayouts(layouts ...string) Option {
+	return func(o *Options) {
+		o.DatetimeLayouts = layouts
+	}
+}
+
+func WithTimeBounds(b TimeBounds) Option {
+	return func(o *Options) {
+		o.TimeBounds = b
+	}
+}
+
+// WithRegisteredMediaTypes rejects the media types missing from the IANA
+// registry, see LoadMediaTypeRegistry.
+func WithRegisteredMediaTypes() Option {
+	return func(o *Options) {
+		o.RegisteredMediaTypes = true
+	}
+}
+
+func newOptions(opts []Option) Options {
+	var o Options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// OptionsValidator is implemented by validators whose behavior depends on
+// the validation options. The registry prefers it over ValidateValue.
+type OptionsValidator interface {
+	ValidateValueWithOptions(value interface{}, opts Options) (interface{}, string, error)
+}
+
+type optionsValidator[T any] struct {
+	dataType string
+	fn       func(value interface{}, opts Options) (T, string, error)
+}
+
+func newOptionsValidator[T any](dataType string, fn func(value interface{}, opts Options) (T, string, error)) *optionsValidator[T] {
+	return &optionsValidator[T]{dataType: dataType, fn: fn}
+}
+
+func (v *optionsValidator[T]) Validate(value any) (Result[T], error) {
+	nv, id, err := v.fn(value, Options{})
+	if err != nil {
+		return Result[T]{}, err
+	}
+
+	return Result[T]{Value: nv, ID: id, DataType: v.dataType}, nil
+}
+
+func (v *optionsValidator[T]) ValidateValue(value interface{}) (interface{}, string, error) {
+	return v.fn(value, Options{})
+}
+
+func (v *optionsValidator[T]) ValidateValueWithOptions(value interface{}, opts Options) (interface{}, string, error) {
+	return v.fn(value, opts)
+}
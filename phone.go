@@ -1,17 +1,227 @@
 package validations
 
-import "fmt"
+import (
+	"regexp"
+	"strings"
+)
 
+type PhoneLineType string
+
+const (
+	PhoneFixedLine         PhoneLineType = "fixed-line"
+	PhoneMobile            PhoneLineType = "mobile"
+	PhoneFixedLineOrMobile PhoneLineType = "fixed-line-or-mobile"
+	PhoneTollFree          PhoneLineType = "toll-free"
+	PhoneUnknown           PhoneLineType = "unknown"
+)
+
+// PhoneNumber is a phone number split in its country calling code and its
+// national significant number.
+type PhoneNumber struct {
+	CountryCode    string        `json:"countryCode"`
+	NationalNumber string        `json:"nationalNumber"`
+	Region         string        `json:"region"`
+	LineType       PhoneLineType `json:"lineType"`
+}
+
+type phonePlan struct {
+	phoneMetadata
+	pattern  *regexp.Regexp
+	mobile   *regexp.Regexp
+	tollFree *regexp.Regexp
+}
+
+var (
+	phonePlansByCode   = map[string][]*phonePlan{}
+	phonePlansByRegion = map[string]*phonePlan{}
+)
+
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "/", "", "\u00a0", "")
+
+func init() {
+	for _, m := range phonePlans {
+		p := &phonePlan{phoneMetadata: m, pattern: regexp.MustCompile(`^(?:` + m.Pattern + `)$`)}
+		if m.Mobile != "" {
+			p.mobile = regexp.MustCompile(`^(?:` + m.Mobile + `)`)
+		}
+		if m.TollFree != "" {
+			p.tollFree = regexp.MustCompile(`^(?:` + m.TollFree + `)`)
+		}
+		phonePlansByCode[m.CallingCode] = append(phonePlansByCode[m.CallingCode], p)
+		phonePlansByRegion[m.Region] = p
+	}
+}
+
+// ParsePhone parses a phone number in international format, starting with +
+// or 00. Numbers in national format are accepted when the region they belong
+// to is given.
+func ParsePhone(s string, region string) (PhoneNumber, error) {
+	digits := phoneSeparators.Replace(strings.TrimSpace(s))
+	switch {
+	case strings.HasPrefix(digits, "+"):
+		digits = digits[1:]
+	case strings.HasPrefix(digits, "00"):
+		digits = digits[2:]
+	case region != "":
+		plan, ok := phonePlansByRegion[strings.ToUpper(region)]
+		if !ok {
+			return PhoneNumber{}, newValidationError(PHONE, CodeInvalid, s, "unknown phone region: %s", region)
+		}
+		if plan.TrunkPrefix != "" {
+			digits = strings.TrimPrefix(digits, plan.TrunkPrefix)
+		}
+		digits = plan.CallingCode + digits
+	default:
+		return PhoneNumber{}, newValidationError(PHONE, CodeInvalid, s, "phone number is not in international format: %s", s)
+	}
+
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return PhoneNumber{}, newValidationError(PHONE, CodeInvalid, s, "invalid phone number: %s", s)
+	}
+
+	if len(digits) > 15 {
+		return PhoneNumber{}, newValidationError(PHONE, CodeInvalid, s, "phone number longer than 15 digits: %s", s)
+	}
+
+	for n := 1; n <= 3 && n < len(digits); n++ {
+		cc, nsn := digits[:n], digits[n:]
+		if plans, ok := phonePlansByCode[cc]; ok {
+			return parseNationalNumber(s, cc, nsn, plans)
+		}
+
+		if region, ok := phoneCallingCodes[cc]; ok {
+			if len(nsn) < 4 {
+				return PhoneNumber{}, newValidationError(PHONE, CodeInvalid, s, "phone number too short: %s", s)
+			}
+			return PhoneNumber{CountryCode: cc, NationalNumber: nsn, Region: region, LineType: PhoneUnknown}, nil
+		}
+	}
+
+	return PhoneNumber{}, newValidationError(PHONE, CodeInvalid, s, "unknown country calling code: %s", s)
+}
+
+func parseNationalNumber(s, cc, nsn string, plans []*phonePlan) (PhoneNumber, error) {
+	for _, candidate := range []string{nsn, strings.TrimPrefix(nsn, "0")} {
+		for _, plan := range plans {
+			if !plan.match(candidate) {
+				continue
+			}
+
+			return PhoneNumber{
+				CountryCode:    cc,
+				NationalNumber: candidate,
+				Region:         plan.Region,
+				LineType:       plan.lineType(candidate),
+			}, nil
+		}
+	}
+
+	return PhoneNumber{}, newValidationError(PHONE, CodeInvalid, s, "invalid phone number for calling code %s: %s", cc, s)
+}
+
+func (p *phonePlan) match(nsn string) bool {
+	if !p.pattern.MatchString(nsn) {
+		return false
+	}
+
+	switch p.Region {
+	case "US":
+		return !hasString(nanpCanadaAreaCodes, nsn[:3])
+	case "CA":
+		return hasString(nanpCanadaAreaCodes, nsn[:3])
+	}
+	return true
+}
+
+func (p *phonePlan) lineType(nsn string) PhoneLineType {
+	switch {
+	case p.tollFree != nil && p.tollFree.MatchString(nsn):
+		return PhoneTollFree
+	case p.mobile == nil:
+		return PhoneFixedLineOrMobile
+	case p.mobile.MatchString(nsn):
+		return PhoneMobile
+	}
+	return PhoneFixedLine
+}
+
+// E164 returns the number in E.164 format, e.g. +12223334444.
+func (p PhoneNumber) E164() string {
+	return "+" + p.CountryCode + p.NationalNumber
+}
+
+// International returns the number formatted for international dialing,
+// e.g. +44 20 7946 0958.
+func (p PhoneNumber) International() string {
+	plan, ok := phonePlansByRegion[p.Region]
+	if !ok {
+		return "+" + p.CountryCode + " " + p.NationalNumber
+	}
+	return "+" + p.CountryCode + " " + formatPhoneDigits(p.NationalNumber, plan.Formats)
+}
+
+// National returns the number formatted for national dialing, e.g.
+// 020 7946 0958.
+func (p PhoneNumber) National() string {
+	plan, ok := phonePlansByRegion[p.Region]
+	switch {
+	case !ok:
+		return p.NationalNumber
+	case plan.NationalFormats != nil:
+		return formatPhoneDigits(p.NationalNumber, plan.NationalFormats)
+	}
+	return plan.TrunkPrefix + formatPhoneDigits(p.NationalNumber, plan.Formats)
+}
+
+func formatPhoneDigits(digits string, formats []string) string {
+	for _, f := range formats {
+		if prefix, template, ok := strings.Cut(f, "|"); ok {
+			if !strings.HasPrefix(digits, prefix) {
+				continue
+			}
+			f = template
+		}
+
+		if strings.Count(f, "#") != len(digits) {
+			continue
+		}
+
+		var b strings.Builder
+		i := 0
+		for _, c := range f {
+			if c == '#' {
+				b.WriteByte(digits[i])
+				i++
+				continue
+			}
+			b.WriteRune(c)
+		}
+		return b.String()
+	}
+
+	if len(digits) > 4 {
+		return digits[:3] + " " + digits[3:]
+	}
+	return digits
+}
+
+// ValidatePhone validates a phone number in international format and
+// returns it in E.164 format.
 func ValidatePhone(value interface{}) (string, string, error) {
+	return validatePhone(value, Options{})
+}
+
+func validatePhone(value interface{}, opts Options) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(PHONE, value)
 	}
 
-	e := ValidateRegEx(`^([+][1-9]{1,1}[0-9]{0,2})([\s]?[(][1-9]{1,1}[0-9]{0,3}[)])?([\s]?[-]?[0-9]{1,4}){1,3}$`, v)
-	if e != nil {
-		return "", "", e
+	p, err := ParsePhone(v, opts.PhoneRegion)
+	if err != nil {
+		return "", "", err
 	}
 
-	return v, GenerateSHA3256(v), nil
+	e164 := p.E164()
+	return e164, GenerateSHA3256(e164), nil
 }
@@ -3,36 +3,47 @@ package validations
 import "testing"
 
 func TestValidatePhone(t *testing.T) {
-	var validPhones = []string{
-		"+11-2222-3333",
-		"+1 (222) 333-4444",
-		"+111 2222 3333",
-		"+1 (123) 12-2-333",
+	var validPhones = map[string]string{
+		"+1 (222) 333-4444":   "+12223334444",
+		"+12223334444":        "+12223334444",
+		"+1 222.333.4444":     "+12223334444",
+		"+44 (0)20 7946 0958": "+442079460958",
+		"0044 7400 123456":    "+447400123456",
+		"+33 6 12 34 56 78":   "+33612345678",
+		"+39 06 6982 0000":    "+390669820000",
+		"+49 30 123456":       "+4930123456",
+		"+81 3-1234-5678":     "+81312345678",
+		"+354 551 2345":       "+3545512345",
+		" +55 11 91234-5678 ": "+5511912345678",
 	}
 
 	var invalidPhones = []string{
 		"+ 11-2222-3333",
-		" +11-2222-3333",
-		"+11-2222-3333 ",
-		"+1 1 222 2-3333",
-		"+1 (12 3) 12-2-333",
+		"+1 (123) 12-2-333",
+		"+1 022 333 4444",
+		"+44 12",
+		"+33 6 12 34 56 78 90",
+		"+999 1234 5678",
+		"+1 222 333 444a",
+		"2223334444",
+		"+1234567890123456",
 	}
 
-	for _, p := range validPhones {
-		vp, _, err := ValidatePhone(p)
+	for in, out := range validPhones {
+		vp, id, err := ValidatePhone(in)
 		if err != nil {
-			t.Error(err)
+			t.Errorf("%s: %v", in, err)
 		}
 
-		if vp != p {
-			t.Errorf("phones are not equals")
+		if vp != out || id != GenerateSHA3256(out) {
+			t.Errorf("%s: expected %s, got %s", in, out, vp)
 		}
 	}
 
 	for _, p := range invalidPhones {
 		vp, _, err := ValidatePhone(p)
 		if err == nil {
-			t.Error("this should return an error")
+			t.Errorf("%s: this should return an error", p)
 		}
 
 		if vp != "" {
@@ -40,3 +51,48 @@ func TestValidatePhone(t *testing.T) {
 		}
 	}
 }
+
+func TestParsePhone(t *testing.T) {
+	var cases = []struct {
+		in            string
+		region        string
+		lineType      PhoneLineType
+		international string
+		national      string
+	}{
+		{"+1 222 333 4444", "US", PhoneFixedLineOrMobile, "+1 222-333-4444", "(222) 333-4444"},
+		{"+1 416 555 0123", "CA", PhoneFixedLineOrMobile, "+1 416-555-0123", "(416) 555-0123"},
+		{"+1 800 555 0123", "US", PhoneTollFree, "+1 800-555-0123", "(800) 555-0123"},
+		{"+44 20 7946 0958", "GB", PhoneFixedLine, "+44 20 7946 0958", "020 7946 0958"},
+		{"+44 7400 123456", "GB", PhoneMobile, "+44 7400 123456", "07400 123456"},
+		{"+7 912 345-67-89", "RU", PhoneMobile, "+7 912 345-67-89", "8912 345-67-89"},
+		{"+7 701 234 5678", "KZ", PhoneMobile, "+7 701 234 5678", "8701 234 5678"},
+		{"+33 1 23 45 67 89", "FR", PhoneFixedLine, "+33 1 23 45 67 89", "01 23 45 67 89"},
+		{"+354 551 2345", "IS", PhoneUnknown, "+354 5512345", "5512345"},
+	}
+
+	for _, c := range cases {
+		p, err := ParsePhone(c.in, "")
+		if err != nil {
+			t.Errorf("%s: %v", c.in, err)
+			continue
+		}
+
+		if p.Region != c.region || p.LineType != c.lineType {
+			t.Errorf("%s: expected %s %s, got %s %s", c.in, c.region, c.lineType, p.Region, p.LineType)
+		}
+
+		if p.International() != c.international || p.National() != c.national {
+			t.Errorf("%s: unexpected formats %s, %s", c.in, p.International(), p.National())
+		}
+	}
+
+	v, _, err := ValidateValue("020 7946 0958", "phone", WithPhoneRegion("GB"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v != "+442079460958" {
+		t.Errorf("expected +442079460958, got %v", v)
+	}
+}
@@ -0,0 +1,111 @@
+package validations
+
+// phoneMetadata describes the numbering plan of a region. Numbers are the
+// national significant number (NSN), without the trunk prefix. Mobile and
+// TollFree match the beginning of the NSN; an empty Mobile means fixed-line
+// and mobile numbers can not be told apart. Formats group the NSN digits, the
+// first format having as many '#' as the number is used. A format may be
+// restricted to the numbers starting with a prefix, e.g. "2|## #### ####".
+type phoneMetadata struct {
+	Region          string
+	CallingCode     string
+	TrunkPrefix     string
+	Pattern         string
+	Mobile          string
+	TollFree        string
+	Formats         []string
+	NationalFormats []string
+}
+
+var phonePlans = []phoneMetadata{
+	{"US", "1", "1", `[2-9]\d{2}[2-9]\d{6}`, "", `8(?:00|33|44|55|66|77|88)`, []string{"###-###-####"}, []string{"(###) ###-####"}},
+	{"CA", "1", "1", `[2-9]\d{2}[2-9]\d{6}`, "", `8(?:00|33|44|55|66|77|88)`, []string{"###-###-####"}, []string{"(###) ###-####"}},
+	{"RU", "7", "8", `[3489]\d{9}`, `9`, `800`, []string{"### ###-##-##"}, nil},
+	{"KZ", "7", "8", `[67]\d{9}`, `7[05-7]`, `800`, []string{"### ### ####"}, nil},
+	{"EG", "20", "0", `[1-9]\d{7,9}`, `1[0125]`, `800`, []string{"### ### ####", "## ### ####", "# ### ####"}, nil},
+	{"ZA", "27", "0", `[1-8]\d{8}`, `[6-8]`, `80`, []string{"## ### ####"}, nil},
+	{"GR", "30", "", `[26]\d{9}`, `69`, `800`, []string{"### ### ####"}, nil},
+	{"NL", "31", "0", `[1-9]\d{8}`, `6`, `800`, []string{"## ### ####"}, nil},
+	{"BE", "32", "0", `[1-9]\d{7,8}`, `4[5-9]`, `800`, []string{"### ## ## ##", "# ### ## ##"}, nil},
+	{"FR", "33", "0", `[1-9]\d{8}`, `[67]`, `80[0-5]`, []string{"# ## ## ## ##"}, nil},
+	{"ES", "34", "", `[5-9]\d{8}`, `[67]`, `[89]00`, []string{"### ## ## ##"}, nil},
+	{"HU", "36", "06", `[1-9]\d{7,8}`, `(?:20|30|31|50|70)`, `80`, []string{"## ### ####", "# ### ####"}, nil},
+	{"IT", "39", "", `[03]\d{5,10}`, `3`, `80[03]`, []string{"### ### ####", "## #### ####"}, nil},
+	{"RO", "40", "0", `[237]\d{8}`, `7`, `800`, []string{"### ### ###"}, nil},
+	{"CH", "41", "0", `[2-9]\d{8}`, `7[5-9]`, `800`, []string{"## ### ## ##"}, nil},
+	{"AT", "43", "0", `[1-9]\d{3,12}`, `6[5-9]`, `800`, nil, nil},
+	{"GB", "44", "0", `[1-357-9]\d{8,9}`, `7[1-57-9]`, `80[08]`, []string{"2|## #### ####", "#### ######", "## #### ###"}, nil},
+	{"DK", "45", "", `[2-9]\d{7}`, "", `80`, []string{"## ## ## ##"}, nil},
+	{"SE", "46", "0", `[1-9]\d{6,8}`, `7[02369]`, `20`, []string{"##-### ## ##", "#-### ## ##"}, nil},
+	{"NO", "47", "", `[2-9]\d{7}`, `[49]`, `80[01]`, []string{"### ## ###"}, nil},
+	{"PL", "48", "", `[1-9]\d{8}`, `(?:45|5[0137]|6[069]|7[2389]|88)`, `800`, []string{"### ### ###"}, nil},
+	{"DE", "49", "0", `[1-9]\d{5,12}`, `1[5-7]`, `800`, nil, nil},
+	{"MX", "52", "", `[1-9]\d{9}`, "", `800`, []string{"### ### ####"}, nil},
+	{"AR", "54", "0", `[1-9]\d{9,10}`, `9`, `800`, []string{"# ## ####-####", "## ####-####"}, nil},
+	{"BR", "55", "0", `[1-9]{2}\d{8,9}`, `[1-9]{2}9`, "", []string{"## #####-####", "## ####-####"}, nil},
+	{"CL", "56", "", `[2-9]\d{8}`, `9`, "", []string{"# #### ####"}, nil},
+	{"CO", "57", "", `[36]\d{9}`, `3`, "", []string{"### #######"}, nil},
+	{"MY", "60", "0", `[1-9]\d{7,9}`, `1`, `1800`, []string{"##-#### ####", "##-### ####", "#-### ####"}, nil},
+	{"AU", "61", "0", `[2-478]\d{8}`, `4`, "", []string{"### ### ###"}, nil},
+	{"ID", "62", "0", `[1-9]\d{7,11}`, `8`, "", nil, nil},
+	{"PH", "63", "0", `[2-9]\d{7,9}`, `9`, "", []string{"### ### ####"}, nil},
+	{"NZ", "64", "0", `[2-9]\d{7,9}`, `2`, `800`, []string{"## ### ####", "# ### ####"}, nil},
+	{"SG", "65", "", `[3689]\d{7}`, `[89]`, "", []string{"#### ####"}, nil},
+	{"TH", "66", "0", `[1-9]\d{7,8}`, `[689]`, "", []string{"## ### ####", "# ### ####"}, nil},
+	{"JP", "81", "0", `[1-9]\d{8,9}`, `[789]0`, `120`, []string{"##-####-####", "#-####-####"}, nil},
+	{"KR", "82", "0", `[1-9]\d{7,9}`, `1[0-9]`, `80`, []string{"##-####-####", "##-###-####", "#-###-####"}, nil},
+	{"VN", "84", "0", `[1-9]\d{8,9}`, `[35789]`, "", []string{"### ### ####", "## ### ####"}, nil},
+	{"CN", "86", "0", `[1-9]\d{9,10}`, `1[3-9]`, `800`, []string{"### #### ####", "### ### ####"}, nil},
+	{"TR", "90", "0", `[2-58]\d{9}`, `5`, `800`, []string{"### ### ## ##"}, nil},
+	{"IN", "91", "0", `[1-9]\d{9}`, `[6-9]`, `1800`, []string{"##### #####"}, nil},
+	{"PK", "92", "0", `[1-9]\d{8,9}`, `3`, "", []string{"### #######", "## #######"}, nil},
+	{"IR", "98", "0", `[1-9]\d{9}`, `9`, "", []string{"### ### ####"}, nil},
+	{"NG", "234", "0", `[1-9]\d{7,9}`, `[789][01]`, "", []string{"### ### ####", "# ### ####"}, nil},
+	{"KE", "254", "0", `[1-7]\d{8}`, `(?:7|1[01])`, "", []string{"### ######"}, nil},
+	{"PT", "351", "", `[29]\d{8}`, `9[1236]`, `800`, []string{"### ### ###"}, nil},
+	{"IE", "353", "0", `[1-9]\d{6,8}`, `8[3-9]`, "", []string{"## ### ####", "# ### ####"}, nil},
+	{"FI", "358", "0", `[1-9]\d{4,11}`, `(?:4|50)`, `800`, nil, nil},
+	{"UA", "380", "0", `[3-9]\d{8}`, `(?:39|50|6[36-8]|7[1-3]|9[1-9])`, `800`, []string{"## ### ####"}, nil},
+	{"SA", "966", "0", `[15]\d{8}`, `5`, "", []string{"## ### ####"}, nil},
+	{"AE", "971", "0", `[2-9]\d{7,8}`, `5`, `800`, []string{"## ### ####", "# ### ####"}, nil},
+	{"IL", "972", "0", `[2-9]\d{7,8}`, `5`, "", []string{"##-###-####", "#-###-####"}, nil},
+}
+
+// phoneCallingCodes maps the other ITU-T E.164 country calling codes to the
+// main region using them. Numbers of these regions are only checked for the
+// E.164 maximum length.
+var phoneCallingCodes = map[string]string{
+	"211": "SS", "212": "MA", "213": "DZ", "216": "TN", "218": "LY", "220": "GM", "221": "SN",
+	"222": "MR", "223": "ML", "224": "GN", "225": "CI", "226": "BF", "227": "NE", "228": "TG",
+	"229": "BJ", "230": "MU", "231": "LR", "232": "SL", "233": "GH", "235": "TD", "236": "CF",
+	"237": "CM", "238": "CV", "239": "ST", "240": "GQ", "241": "GA", "242": "CG", "243": "CD",
+	"244": "AO", "245": "GW", "246": "IO", "248": "SC", "249": "SD", "250": "RW", "251": "ET",
+	"252": "SO", "253": "DJ", "255": "TZ", "256": "UG", "257": "BI", "258": "MZ", "260": "ZM",
+	"261": "MG", "262": "RE", "263": "ZW", "264": "NA", "265": "MW", "266": "LS", "267": "BW",
+	"268": "SZ", "269": "KM", "290": "SH", "291": "ER", "297": "AW", "298": "FO", "299": "GL",
+	"350": "GI", "352": "LU", "354": "IS", "355": "AL", "356": "MT", "357": "CY", "359": "BG",
+	"370": "LT", "371": "LV", "372": "EE", "373": "MD", "374": "AM", "375": "BY", "376": "AD",
+	"377": "MC", "378": "SM", "381": "RS", "382": "ME", "383": "XK", "385": "HR", "386": "SI",
+	"387": "BA", "389": "MK", "420": "CZ", "421": "SK", "423": "LI", "500": "FK", "501": "BZ",
+	"502": "GT", "503": "SV", "504": "HN", "505": "NI", "506": "CR", "507": "PA", "508": "PM",
+	"509": "HT", "51": "PE", "53": "CU", "58": "VE", "590": "GP", "591": "BO", "592": "GY",
+	"593": "EC", "594": "GF", "595": "PY", "596": "MQ", "597": "SR", "598": "UY", "599": "CW",
+	"670": "TL", "672": "NF", "673": "BN", "674": "NR", "675": "PG", "676": "TO", "677": "SB",
+	"678": "VU", "679": "FJ", "680": "PW", "681": "WF", "682": "CK", "683": "NU", "685": "WS",
+	"686": "KI", "687": "NC", "688": "TV", "689": "PF", "690": "TK", "691": "FM", "692": "MH",
+	"850": "KP", "852": "HK", "853": "MO", "855": "KH", "856": "LA", "880": "BD", "886": "TW",
+	"93": "AF", "94": "LK", "95": "MM", "960": "MV", "961": "LB", "962": "JO", "963": "SY",
+	"964": "IQ", "965": "KW", "967": "YE", "968": "OM", "970": "PS", "973": "BH", "974": "QA",
+	"975": "BT", "976": "MN", "977": "NP", "992": "TJ", "993": "TM", "994": "AZ", "995": "GE",
+	"996": "KG", "998": "UZ",
+}
+
+// nanpCanadaAreaCodes lists the NANP area codes assigned to Canada, the other
+// numbers of calling code 1 are reported as US.
+var nanpCanadaAreaCodes = []string{
+	"204", "226", "236", "249", "250", "263", "289", "306", "343", "354", "365", "367", "368",
+	"382", "403", "416", "418", "428", "431", "437", "438", "450", "468", "474", "506", "514",
+	"519", "548", "579", "581", "584", "587", "600", "604", "613", "639", "647", "672", "683",
+	"705", "709", "742", "753", "778", "780", "782", "807", "819", "825", "867", "873", "879",
+	"902", "905",
+}
@@ -0,0 +1,220 @@
+package validations
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/idna"
+)
+
+//go:embed public_suffix_list.dat
+var publicSuffixData string
+
+// PublicSuffixList holds the rules of a Public Suffix List, see
+// https://publicsuffix.org/list/. Rules are stored in A-label form.
+type PublicSuffixList struct {
+	rules      map[string]bool
+	wildcards  map[string]bool
+	exceptions map[string]bool
+}
+
+// DomainParts splits a domain name around its public suffix. Registrable is
+// empty when the name is itself a public suffix.
+type DomainParts struct {
+	Suffix      string   `json:"suffix"`
+	Registrable string   `json:"registrable"`
+	Subdomain   []string `json:"subdomain"`
+	ICANN       bool     `json:"icann"`
+}
+
+var (
+	publicSuffixMu   sync.RWMutex
+	publicSuffixList *PublicSuffixList
+)
+
+func init() {
+	l, err := ParsePublicSuffixList(strings.NewReader(publicSuffixData))
+	if err != nil {
+		panic(err)
+	}
+	publicSuffixList = l
+}
+
+// ParsePublicSuffixList parses a list in the publicsuffix.org format.
+func ParsePublicSuffixList(r io.Reader) (*PublicSuffixList, error) {
+	l := &PublicSuffixList{
+		rules:      make(map[string]bool),
+		wildcards:  make(map[string]bool),
+		exceptions: make(map[string]bool),
+	}
+
+	icann := false
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		switch {
+		case strings.Contains(line, "===BEGIN ICANN DOMAINS==="):
+			icann = true
+			continue
+		case strings.Contains(line, "===END ICANN DOMAINS==="):
+			icann = false
+			continue
+		case line == "" || strings.HasPrefix(line, "//"):
+			continue
+		}
+
+		rule := strings.Fields(line)[0]
+		rules := l.rules
+		switch {
+		case strings.HasPrefix(rule, "!"):
+			rule, rules = rule[1:], l.exceptions
+		case strings.HasPrefix(rule, "*."):
+			rule, rules = rule[2:], l.wildcards
+		}
+
+		ascii, err := idna.Lookup.ToASCII(rule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public suffix rule at line %d: %w", n, err)
+		}
+		rules[ascii] = icann
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// LoadPublicSuffixList replaces the list used by SplitDomain, e.g. with a
+// fresh copy downloaded from publicsuffix.org.
+func LoadPublicSuffixList(r io.Reader) error {
+	l, err := ParsePublicSuffixList(r)
+	if err != nil {
+		return err
+	}
+
+	publicSuffixMu.Lock()
+	defer publicSuffixMu.Unlock()
+
+	publicSuffixList = l
+	return nil
+}
+
+// SplitDomain splits a domain name using the current Public Suffix List.
+func SplitDomain(name string) (DomainParts, error) {
+	publicSuffixMu.RLock()
+	l := publicSuffixList
+	publicSuffixMu.RUnlock()
+
+	return l.Split(name)
+}
+
+// Split returns the public suffix (eTLD), the registrable domain (eTLD+1)
+// and the subdomain labels of a domain name, all in A-label form.
+func (l *PublicSuffixList) Split(name string) (DomainParts, error) {
+	d, err := ParseFQDN(name)
+	if err != nil {
+		return DomainParts{}, err
+	}
+
+	labels := strings.Split(d.ASCII, ".")
+	i, icann := l.suffixIndex(labels)
+
+	parts := DomainParts{Suffix: strings.Join(labels[i:], "."), ICANN: icann}
+	if i > 0 {
+		parts.Registrable = strings.Join(labels[i-1:], ".")
+		parts.Subdomain = labels[:i-1]
+	}
+	return parts, nil
+}
+
+// suffixIndex returns the index of the first label of the public suffix,
+// applying the prevailing rule: exceptions first, then the longest match,
+// then the implicit "*" rule.
+func (l *PublicSuffixList) suffixIndex(labels []string) (int, bool) {
+	for i := range labels {
+		if icann, ok := l.exceptions[strings.Join(labels[i:], ".")]; ok {
+			return i + 1, icann
+		}
+	}
+
+	for i := range labels {
+		if icann, ok := l.rules[strings.Join(labels[i:], ".")]; ok {
+			return i, icann
+		}
+		if i+1 < len(labels) {
+			if icann, ok := l.wildcards[strings.Join(labels[i+1:], ".")]; ok {
+				return i, icann
+			}
+		}
+	}
+
+	return len(labels) - 1, false
+}
+
+// ValidateDomain validates a domain name with the options of the domain
+// Definition, the same as ValidateValue, and returns its A-label form. Only
+// registrable domains, i.e. names directly under a public suffix, are
+// accepted with WithRegistrableDomain.
+func ValidateDomain(value interface{}) (string, string, error) {
+	return validateDomain(value, newOptions(domain.Options))
+}
+
+func validateDomain(value interface{}, opts Options) (string, string, error) {
+	v, id, err := validateFQDN(value, opts)
+	if err != nil {
+		return "", "", wrapValidationError(DOMAIN, CodeInvalid, value, err)
+	}
+
+	if !opts.RegistrableDomain {
+		return v, id, nil
+	}
+
+	parts, err := SplitDomain(v)
+	if err != nil {
+		return "", "", wrapValidationError(DOMAIN, CodeInvalid, value, err)
+	}
+
+	if parts.Registrable == "" || len(parts.Subdomain) > 0 {
+		return "", "", newValidationError(DOMAIN, CodeNotRegistrable, value, "value '%v' is not a registrable domain", value)
+	}
+	return v, id, nil
+}
+
+// hostnameDomainHook fills the domain attribute of hostnames with their
+// registrable domain when it is missing.
+func hostnameDomainHook(entity *Entity) []error {
+	if _, ok := entity.Attributes[domain.Type]; ok {
+		return nil
+	}
+
+	name, ok := entity.Attributes[entity.Type].(string)
+	if !ok {
+		return nil
+	}
+
+	if parts, err := SplitDomain(name); err == nil && parts.Registrable != "" {
+		entity.Attributes[domain.Type] = parts.Registrable
+	}
+	return nil
+}
+
+// isKnownSuffix reports whether the top level domain of name is listed in
+// the current Public Suffix List, including the TLDs only listed as the
+// parent of a wildcard rule, e.g. *.ck.
+func isKnownSuffix(name string) bool {
+	publicSuffixMu.RLock()
+	l := publicSuffixList
+	publicSuffixMu.RUnlock()
+
+	tld := strings.ToLower(name[strings.LastIndex(name, ".")+1:])
+	if _, ok := l.rules[tld]; ok {
+		return true
+	}
+	_, ok := l.wildcards[tld]
+	return ok
+}
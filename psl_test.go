@@ -0,0 +1,96 @@
+package validations
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitDomain(t *testing.T) {
+	var cases = map[string]DomainParts{
+		"example.com":            {Suffix: "com", Registrable: "example.com", Subdomain: []string{}, ICANN: true},
+		"www.example.co.uk":      {Suffix: "co.uk", Registrable: "example.co.uk", Subdomain: []string{"www"}, ICANN: true},
+		"a.b.example.com":        {Suffix: "com", Registrable: "example.com", Subdomain: []string{"a", "b"}, ICANN: true},
+		"co.uk":                  {Suffix: "co.uk", ICANN: true},
+		"foo.bar.ck":             {Suffix: "bar.ck", Registrable: "foo.bar.ck", Subdomain: []string{}, ICANN: true},
+		"www.ck":                 {Suffix: "ck", Registrable: "www.ck", Subdomain: []string{}, ICANN: true},
+		"a.www.ck":               {Suffix: "ck", Registrable: "www.ck", Subdomain: []string{"a"}, ICANN: true},
+		"foo.github.io":          {Suffix: "github.io", Registrable: "foo.github.io", Subdomain: []string{}},
+		"www.example.unknowntld": {Suffix: "unknowntld", Registrable: "example.unknowntld", Subdomain: []string{"www"}},
+		"www.bücher.xn--p1ai":    {Suffix: "xn--p1ai", Registrable: "xn--bcher-kva.xn--p1ai", Subdomain: []string{"www"}, ICANN: true},
+		"shop.city.kawasaki.jp":  {Suffix: "kawasaki.jp", Registrable: "city.kawasaki.jp", Subdomain: []string{"shop"}, ICANN: true},
+	}
+
+	for in, out := range cases {
+		parts, err := SplitDomain(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(parts, out) {
+			t.Errorf("%s: expected %+v, got %+v", in, out, parts)
+		}
+	}
+}
+
+func TestLoadPublicSuffixList(t *testing.T) {
+	defer func() {
+		if err := LoadPublicSuffixList(strings.NewReader(publicSuffixData)); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	list := "// ===BEGIN ICANN DOMAINS===\ncom\n// ===END ICANN DOMAINS===\nexample.com\n"
+	if err := LoadPublicSuffixList(strings.NewReader(list)); err != nil {
+		t.Fatal(err)
+	}
+
+	parts, err := SplitDomain("foo.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parts.Registrable != "foo.example.com" || parts.ICANN {
+		t.Errorf("unexpected parts %+v", parts)
+	}
+}
+
+func TestValidateDomain(t *testing.T) {
+	for _, in := range []string{"example.com", "example.co.uk", "Bücher.de"} {
+		if _, _, err := ValidateValue(in, "domain", WithRegistrableDomain()); err != nil {
+			t.Errorf("%s: %v", in, err)
+		}
+	}
+
+	for _, in := range []string{"www.example.com", "co.uk", "github.io"} {
+		if _, _, err := ValidateValue(in, "domain"); err != nil {
+			t.Errorf("%s: %v", in, err)
+		}
+
+		if _, _, err := ValidateDomain(in); err != nil {
+			t.Errorf("%s: %v", in, err)
+		}
+
+		_, _, err := ValidateValue(in, "domain", WithRegistrableDomain())
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Code != CodeNotRegistrable {
+			t.Errorf("%s: expected a not registrable error, got %v", in, err)
+		}
+	}
+}
+
+func TestHostnameDomain(t *testing.T) {
+	e, errs := ValidateEntity(Entity{
+		Type:       "hostname",
+		Attributes: map[string]interface{}{"hostname": "mail.example.co.uk"},
+	})
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+
+	if e.Attributes["domain"] != "example.co.uk" {
+		t.Errorf("expected domain example.co.uk, got %v", e.Attributes["domain"])
+	}
+}
@@ -1,19 +1,82 @@
 package validations
 
 import (
-	"fmt"
+	"container/list"
 	"regexp"
+	"sync"
 )
 
+var (
+	hex16Regex  = regexp.MustCompile(`^[0-9a-f]{16}$`)
+	hex32Regex  = regexp.MustCompile(`^[0-9a-f]{32}$`)
+	hex40Regex  = regexp.MustCompile(`^[0-9a-f]{40}$`)
+	hex56Regex  = regexp.MustCompile(`^[0-9a-f]{56}$`)
+	hex64Regex  = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	hex96Regex  = regexp.MustCompile(`^[0-9a-f]{96}$`)
+	hex128Regex = regexp.MustCompile(`^[0-9a-f]{128}$`)
+)
+
+// regexCacheSize bounds the number of caller supplied patterns kept by
+// ValidateRegEx.
+const regexCacheSize = 256
+
+// regexCache holds the expressions compiled by ValidateRegEx, or their
+// compile error, keyed by pattern. The least recently used pattern is
+// evicted once the cache is full.
+var regexCache = struct {
+	sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}{entries: map[string]*list.Element{}, order: list.New()}
+
+type regexCacheEntry struct {
+	pattern    string
+	expression *regexp.Regexp
+	err        error
+}
+
+// ValidateRegEx checks that the whole value matches regex. Patterns are
+// compiled once and cached, and an invalid pattern is reported as an error.
 func ValidateRegEx(regex, value string) error {
-	expression := regexp.MustCompile(regex)
-	matches := expression.FindAllString(value, -1)
-	if len(matches) != 1 {
-		return fmt.Errorf("value '%s' does not match with regexp '%s'", value, regex)
+	expression, err := compileRegEx(regex)
+	if err != nil {
+		return err
 	}
 
-	if matches[0] != value {
-		return fmt.Errorf("value '%s' does not match with regexp '%s'", value, regex)
+	return matchRegEx("", expression, value)
+}
+
+func compileRegEx(regex string) (*regexp.Regexp, error) {
+	regexCache.Lock()
+	defer regexCache.Unlock()
+
+	if e, ok := regexCache.entries[regex]; ok {
+		regexCache.order.MoveToFront(e)
+		entry := e.Value.(*regexCacheEntry)
+		return entry.expression, entry.err
+	}
+
+	entry := &regexCacheEntry{pattern: regex}
+	expression, err := regexp.Compile(regex)
+	if err != nil {
+		entry.err = newValidationError("", CodeInvalidPattern, regex, "invalid regexp '%s': %v", regex, err)
+	} else {
+		entry.expression = expression
+	}
+
+	regexCache.entries[regex] = regexCache.order.PushFront(entry)
+	if regexCache.order.Len() > regexCacheSize {
+		oldest := regexCache.order.Remove(regexCache.order.Back()).(*regexCacheEntry)
+		delete(regexCache.entries, oldest.pattern)
+	}
+
+	return entry.expression, entry.err
+}
+
+func matchRegEx(dataType string, expression *regexp.Regexp, value string) error {
+	matches := expression.FindAllString(value, -1)
+	if len(matches) != 1 || matches[0] != value {
+		return newValidationError(dataType, CodeRegexMismatch, value, "value '%s' does not match with regexp '%s'", value, expression)
 	}
 
 	return nil
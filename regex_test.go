@@ -0,0 +1,69 @@
+package validations
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+const benchmarkSHA256 = "202492bdd391deac6c1e72eba9d039a7c60bcc61f1afa0d85269d8c4c5af1284"
+
+func TestValidateRegEx(t *testing.T) {
+	if err := ValidateRegEx(`^[a-z]+$`, "hello"); err != nil {
+		t.Error(err)
+	}
+
+	if err := ValidateRegEx(`^[a-z]+$`, "Hello"); err == nil {
+		t.Error("this should return an error")
+	}
+
+	err := ValidateRegEx(`^[a-z+$`, "hello")
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Code != CodeInvalidPattern {
+		t.Errorf("expected an invalid pattern error, got %v", err)
+	}
+}
+
+func TestRegExCache(t *testing.T) {
+	for i := 0; i < 2*regexCacheSize; i++ {
+		if err := ValidateRegEx(fmt.Sprintf(`^[a-z]{%d}$`, i), "a"); err == nil && i != 1 {
+			t.Errorf("%d: this should return an error", i)
+		}
+	}
+
+	if n := regexCache.order.Len(); n != regexCacheSize || len(regexCache.entries) != n {
+		t.Errorf("expected %d cached patterns, got %d", regexCacheSize, n)
+	}
+
+	_, err1 := compileRegEx(`^[a-z+$`)
+	_, err2 := compileRegEx(`^[a-z+$`)
+	if err1 == nil || err1 != err2 {
+		t.Errorf("compile errors should be cached, got %v and %v", err1, err2)
+	}
+}
+
+func BenchmarkValidateRegEx(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = ValidateRegEx(`^[0-9a-f]{64}$`, benchmarkSHA256)
+	}
+}
+
+func BenchmarkValidateRegExUncached(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		expression := regexp.MustCompile(`^[0-9a-f]{64}$`)
+		_ = matchRegEx("", expression, benchmarkSHA256)
+	}
+}
+
+func BenchmarkValidateSHA256(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _, _ = ValidateSHA256(benchmarkSHA256)
+	}
+}
+
+func BenchmarkValidatePhone(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _, _ = ValidatePhone("+1 (222) 333-4444")
+	}
+}
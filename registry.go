@@ -0,0 +1,378 @@
+package validations
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ValueValidator validates a single value, returning the normalized value
+// and its SHA3-256 identifier.
+type ValueValidator interface {
+	ValidateValue(value interface{}) (interface{}, string, error)
+}
+
+type ValidatorFunc func(value interface{}) (interface{}, string, error)
+
+func (f ValidatorFunc) ValidateValue(value interface{}) (interface{}, string, error) {
+	return f(value)
+}
+
+// Registry maps data types to validators and entity types to definitions.
+type Registry struct {
+	mu          sync.RWMutex
+	validators  map[string]ValueValidator
+	definitions map[string]Definition
+	order       []string
+	hooks       map[string][]EntityOptionsHook
+}
+
+// EntityHook runs after the values of an entity were validated. It may
+// complete the normalized entity and report errors with paths relative to
+// the entity.
+type EntityHook func(entity *Entity) []error
+
+// EntityOptionsHook is an EntityHook whose behavior depends on the
+// validation options.
+type EntityOptionsHook func(entity *Entity, opts Options) []error
+
+var DefaultRegistry = NewDefaultRegistry()
+
+func NewRegistry() *Registry {
+	return &Registry{
+		validators:  make(map[string]ValueValidator),
+		definitions: make(map[string]Definition),
+		hooks:       make(map[string][]EntityOptionsHook),
+	}
+}
+
+// NewDefaultRegistry returns a registry with the built-in validators and
+// definitions registered.
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+
+	r.RegisterValidator(STR, newFuncValidator(STR, func(value interface{}) (string, string, error) {
+		return ValidateString(value, false)
+	}))
+	r.RegisterValidator(ISTR, newFuncValidator(ISTR, func(value interface{}) (string, string, error) {
+		return ValidateString(value, true)
+	}))
+	r.RegisterValidator(IP, newOptionsValidator(IP, func(value interface{}, opts Options) (string, string, error) {
+		return ValidateIP(value, opts.IPPolicy)
+	}))
+	r.RegisterValidator(EMAIL, newFuncValidator(EMAIL, ValidateEmail))
+	r.RegisterValidator(FQDN, newOptionsValidator(FQDN, validateFQDN))
+	r.RegisterValidator(DOMAIN, newOptionsValidator(DOMAIN, validateDomain))
+	r.RegisterValidator(INTEGER, newFuncValidator(INTEGER, ValidateInteger))
+	r.RegisterValidator(CIDR, newOptionsValidator(CIDR, func(value interface{}, opts Options) (string, string, error) {
+		return ValidateCIDR(value, opts.IPPolicy)
+	}))
+	r.RegisterValidator(CITY, newOptionsValidator(CITY, validateCity))
+	r.RegisterValidator(COUNTRY, newOptionsValidator(COUNTRY, validateCountry))
+	r.RegisterValidator(FLOAT, newFuncValidator(FLOAT, ValidateFloat))
+	r.RegisterValidator(BOOLEAN, newFuncValidator(BOOLEAN, ValidateBoolean))
+	r.RegisterValidator(URL, newOptionsValidator(URL, validateURL))
+	r.RegisterValidator(MD5, newFuncValidator(MD5, ValidateMD5))
+	r.RegisterValidator(HEXADECIMAL, newFuncValidator(HEXADECIMAL, ValidateHexadecimal))
+	r.RegisterValidator(BASE64, newFuncValidator(BASE64, ValidateBase64))
+	r.RegisterValidator(DATE, newOptionsValidator(DATE, validateDate))
+	r.RegisterValidator(MAC, newFuncValidator(MAC, ValidateMAC))
+	r.RegisterValidator(MIME, newOptionsValidator(MIME, validateMime))
+	r.RegisterValidator(PHONE, newOptionsValidator(PHONE, validatePhone))
+	r.RegisterValidator(SHA1, newFuncValidator(SHA1, ValidateSHA1))
+	r.RegisterValidator(SHA224, newFuncValidator(SHA224, ValidateSHA224))
+	r.RegisterValidator(SHA256, newFuncValidator(SHA256, ValidateSHA256))
+	r.RegisterValidator(SHA384, newFuncValidator(SHA384, ValidateSHA384))
+	r.RegisterValidator(SHA512, newFuncValidator(SHA512, ValidateSHA512))
+	r.RegisterValidator(SHA3_224, newFuncValidator(SHA3_224, ValidateSHA3224))
+	r.RegisterValidator(SHA3_256, newFuncValidator(SHA3_256, ValidateSHA3256))
+	r.RegisterValidator(SHA3_384, newFuncValidator(SHA3_384, ValidateSHA3384))
+	r.RegisterValidator(SHA3_512, newFuncValidator(SHA3_512, ValidateSHA3512))
+	r.RegisterValidator(SHA512_224, newFuncValidator(SHA512_224, ValidateSHA512224))
+	r.RegisterValidator(SHA512_256, newFuncValidator(SHA512_256, ValidateSHA512256))
+	r.RegisterValidator(SSDEEP, newFuncValidator(SSDEEP, ValidateSSDEEP))
+	r.RegisterValidator(TLSH, newFuncValidator(TLSH, ValidateTLSH))
+	r.RegisterValidator(HASH, newFuncValidator(HASH, ValidateHash))
+	r.RegisterValidator(DATETIME, newOptionsValidator(DATETIME, validateDatetime))
+	r.RegisterValidator(UUID, newFuncValidator(UUID, ValidateUUID))
+	r.RegisterValidator(PATH, newFuncValidator(PATH, ValidatePath))
+	r.RegisterValidator(OBJECT, newFuncValidator(OBJECT, ValidateObject))
+	r.RegisterValidator(ADVERSARY, newFuncValidator(ADVERSARY, ValidateAdversary))
+	r.RegisterValidator(CVE, newFuncValidator(CVE, ValidateCVE))
+	r.RegisterValidator(CPE, newFuncValidator(CPE, ValidateCPE))
+	r.RegisterValidator(BTC, newFuncValidator(BTC, ValidateBTC))
+	r.RegisterValidator(XMR, newFuncValidator(XMR, ValidateXMR))
+	r.RegisterValidator(DASH, newFuncValidator(DASH, ValidateDash))
+	r.RegisterValidator(IBAN, newFuncValidator(IBAN, ValidateIBAN))
+	r.RegisterValidator(BIC, newFuncValidator(BIC, ValidateBIC))
+	r.RegisterValidator(CARD, newOptionsValidator(CARD, validateCardNumber))
+	r.RegisterValidator(ABA, newFuncValidator(ABA, ValidateABA))
+	r.RegisterValidator(LATITUDE, newFuncValidator(LATITUDE, ValidateLatitude))
+	r.RegisterValidator(LONGITUDE, newFuncValidator(LONGITUDE, ValidateLongitude))
+
+	for _, def := range Definitions {
+		r.RegisterDefinition(def)
+	}
+
+	r.RegisterEntityHook(ccNumber.Type, cardIssuerHook)
+	r.RegisterEntityHook(hostname.Type, hostnameDomainHook)
+	r.RegisterEntityHook(macAddr.Type, macVendorHook)
+	r.RegisterEntityHook(filename.Type, filenameMimeHook)
+	r.RegisterEntityHook(file.Type, fileHashHook)
+	r.RegisterEntityOptionsHook(subnet.Type, cityHook(true))
+	r.RegisterEntityOptionsHook(airport.Type, cityHook(false))
+
+	for _, def := range Definitions {
+		_, hasLat := lookupDefinition(def.Attributes, latitude.Type)
+		_, hasLng := lookupDefinition(def.Attributes, longitude.Type)
+		if hasLat && hasLng {
+			r.RegisterEntityHook(def.Type, geoPointHook)
+		}
+
+		if types := domainAttributes(def); len(types) > 0 {
+			r.RegisterEntityHook(def.Type, mixedScriptHook(types))
+		}
+	}
+
+	return r
+}
+
+// RegisterValidator registers the validator for a data type, replacing any
+// validator previously registered for it.
+func (r *Registry) RegisterValidator(dataType string, v ValueValidator) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.validators[dataType] = v
+}
+
+func (r *Registry) Validator(dataType string) (ValueValidator, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	v, ok := r.validators[dataType]
+	return v, ok
+}
+
+// RegisterDefinition registers a definition, replacing any definition
+// previously registered with the same type.
+func (r *Registry) RegisterDefinition(def Definition) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.definitions[def.Type]; !ok {
+		r.order = append(r.order, def.Type)
+	}
+	r.definitions[def.Type] = def
+}
+
+// RegisterEntityHook adds a hook run for every entity of type t.
+func (r *Registry) RegisterEntityHook(t string, hook EntityHook) {
+	r.RegisterEntityOptionsHook(t, func(entity *Entity, opts Options) []error {
+		return hook(entity)
+	})
+}
+
+func (r *Registry) RegisterEntityOptionsHook(t string, hook EntityOptionsHook) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.hooks[t] = append(r.hooks[t], hook)
+}
+
+func (r *Registry) entityHooks(t string) []EntityOptionsHook {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.hooks[t]
+}
+
+func (r *Registry) Definition(t string) (Definition, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	def, ok := r.definitions[t]
+	return def, ok
+}
+
+// Definitions returns the registered definitions in registration order.
+func (r *Registry) Definitions() []Definition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	defs := make([]Definition, 0, len(r.order))
+	for _, t := range r.order {
+		defs = append(defs, r.definitions[t])
+	}
+	return defs
+}
+
+func (r *Registry) ValidateValue(value interface{}, t string, opts ...Option) (interface{}, string, error) {
+	def, ok := r.Definition(t)
+	if !ok {
+		return nil, "", &ValidationError{Type: t, Code: CodeUnknownType, Value: value, Message: fmt.Sprintf("unknown type: %s", t)}
+	}
+
+	return r.validateDefinition(value, def, opts)
+}
+
+// validateDefinition validates value with the validator of the definition
+// data type. The options of the definition apply before opts.
+func (r *Registry) validateDefinition(value interface{}, def Definition, opts []Option) (interface{}, string, error) {
+	v, ok := r.Validator(def.DataType)
+	if !ok {
+		return nil, "", &ValidationError{
+			Type:     def.Type,
+			DataType: def.DataType,
+			Code:     CodeUnknownValidator,
+			Value:    value,
+			Message:  fmt.Sprintf("unknown validator for value: %v", value),
+		}
+	}
+
+	o := newOptions(append(append([]Option{}, def.Options...), opts...))
+	if s, ok := value.(string); ok && o.Refang && refangable(def.DataType) {
+		value = Refang(s)
+	}
+
+	var nv interface{}
+	var id string
+	var err error
+	if ov, ok := v.(OptionsValidator); ok {
+		nv, id, err = ov.ValidateValueWithOptions(value, o)
+	} else {
+		nv, id, err = v.ValidateValue(value)
+	}
+	if err != nil {
+		return nil, "", asValidationError(err, def.Type, def.DataType, value)
+	}
+
+	return nv, id, nil
+}
+
+// ValidateEntity validates the entity value, its attributes and its
+// associations against the definition of the entity type. It returns a
+// normalized copy of the entity together with every error found.
+func (r *Registry) ValidateEntity(entity Entity, opts ...Option) (Entity, []error) {
+	def, ok := r.Definition(entity.Type)
+	if !ok {
+		return Entity{}, []error{&ValidationError{Type: entity.Type, Code: CodeUnknownType, Message: fmt.Sprintf("unknown type: %s", entity.Type)}}
+	}
+
+	return r.validateEntity(entity, def, "", opts)
+}
+
+func (r *Registry) validateEntity(entity Entity, def Definition, path string, opts []Option) (Entity, []error) {
+	var errs []error
+
+	normalized := entity
+	normalized.Attributes = make(map[string]interface{}, len(entity.Attributes))
+	normalized.Associations = nil
+	normalized.Warnings = nil
+
+	if _, ok := entity.Attributes[entity.Type]; !ok {
+		errs = append(errs, &ValidationError{
+			Type:     def.Type,
+			DataType: def.DataType,
+			Code:     CodeMissingValue,
+			Path:     joinPath(path, "attributes", entity.Type),
+			Message:  "missing value",
+		})
+	}
+
+	// Attributes are validated in key order so errors are reported in a
+	// stable order.
+	keys := make([]string, 0, len(entity.Attributes))
+	for key := range entity.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := entity.Attributes[key]
+		p := joinPath(path, "attributes", key)
+		vdef := def
+		if key != def.Type {
+			adef, ok := r.nestedDefinition(def.Attributes, key)
+			if !ok {
+				errs = append(errs, &ValidationError{
+					Type:    key,
+					Code:    CodeUnknownAttribute,
+					Value:   value,
+					Path:    p,
+					Message: fmt.Sprintf("unknown attribute for type %s", def.Type),
+				})
+				continue
+			}
+			vdef = adef
+		}
+
+		v, _, err := r.validateDefinition(value, vdef, opts)
+		if err != nil {
+			errs = append(errs, withPath(err, p))
+			continue
+		}
+		normalized.Attributes[key] = v
+	}
+
+	hookOpts := newOptions(append(append([]Option{}, def.Options...), opts...))
+	for _, hook := range r.entityHooks(def.Type) {
+		for _, err := range hook(&normalized, hookOpts) {
+			errs = append(errs, withPathPrefix(err, path))
+		}
+	}
+
+	for i, association := range entity.Associations {
+		p := fmt.Sprintf("%s[%d]", joinPath(path, "associations"), i)
+		adef, ok := r.nestedDefinition(def.Associations, association.Type)
+		if !ok {
+			errs = append(errs, &ValidationError{
+				Type:    association.Type,
+				Code:    CodeUnknownAssociation,
+				Path:    p,
+				Message: fmt.Sprintf("unknown association %s for type %s", association.Type, def.Type),
+			})
+			continue
+		}
+
+		a, aerrs := r.validateEntity(association, adef, p, opts)
+		errs = append(errs, aerrs...)
+		normalized.Associations = append(normalized.Associations, a)
+	}
+
+	return normalized, errs
+}
+
+// nestedDefinition looks up t in defs, preferring the registered definition
+// so that overrides also apply to attributes and associations.
+func (r *Registry) nestedDefinition(defs []Definition, t string) (Definition, bool) {
+	def, ok := lookupDefinition(defs, t)
+	if !ok {
+		return Definition{}, false
+	}
+
+	if rdef, ok := r.Definition(t); ok {
+		return rdef, true
+	}
+	return def, true
+}
+
+func lookupDefinition(defs []Definition, t string) (Definition, bool) {
+	for _, def := range defs {
+		if def.Type == t {
+			return def, true
+		}
+	}
+	return Definition{}, false
+}
+
+func joinPath(path string, elems ...string) string {
+	for _, e := range elems {
+		if path == "" {
+			path = e
+			continue
+		}
+		path += "." + e
+	}
+	return path
+}
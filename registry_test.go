@@ -0,0 +1,46 @@
+package validations
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewDefaultRegistry()
+
+	r.RegisterValidator("Asset ID", ValidatorFunc(func(value interface{}) (interface{}, string, error) {
+		v, ok := value.(string)
+		if !ok || !strings.HasPrefix(v, "AST-") {
+			return nil, "", fmt.Errorf("invalid asset id: %v", value)
+		}
+		return v, GenerateSHA3256(v), nil
+	}))
+	r.RegisterDefinition(Definition{
+		Type:        "asset",
+		Description: "Internal asset",
+		DataType:    "Asset ID",
+		Attributes:  []Definition{hostname},
+	})
+
+	if _, _, err := r.ValidateValue("AST-1", "asset"); err != nil {
+		t.Error(err)
+	}
+
+	if _, _, err := r.ValidateValue("1", "asset"); err == nil {
+		t.Error("this should return an error")
+	}
+
+	if _, _, err := ValidateValue("AST-1", "asset"); err == nil {
+		t.Error("default registry should not know custom types")
+	}
+
+	r.RegisterDefinition(Definition{Type: "hostname", DataType: "Asset ID"})
+	_, errs := r.ValidateEntity(Entity{
+		Type:       "asset",
+		Attributes: map[string]interface{}{"asset": "AST-1", "hostname": "AST-2"},
+	})
+	if len(errs) != 0 {
+		t.Errorf("overridden definition should apply to attributes: %v", errs)
+	}
+}
@@ -1,18 +1,17 @@
 package validations
 
 import (
-	"fmt"
 	"strings"
 )
 
 func ValidateSHA1(value interface{}) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(SHA1, value)
 	}
 
 	v = strings.ToLower(v)
-	e := ValidateRegEx(`^[0-9a-f]{40}$`, v)
+	e := matchRegEx(SHA1, hex40Regex, v)
 	if e != nil {
 		return "", "", e
 	}
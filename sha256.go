@@ -1,17 +1,16 @@
 package validations
 
 import (
-	"fmt"
 	"strings"
 )
 
 func ValidateSHA256(value interface{}) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(SHA256, value)
 	}
 	v = strings.ToLower(v)
-	e := ValidateRegEx(`^[0-9a-f]{64}$`, v)
+	e := matchRegEx(SHA256, hex64Regex, v)
 	if e != nil {
 		return "", "", e
 	}
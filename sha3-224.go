@@ -1,17 +1,16 @@
 package validations
 
 import (
-	"fmt"
 	"strings"
 )
 
 func ValidateSHA3224(value interface{}) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(SHA3_224, value)
 	}
 	v = strings.ToLower(v)
-	e := ValidateRegEx(`^[0-9a-f]{56}$`, v)
+	e := matchRegEx(SHA3_224, hex56Regex, v)
 	if e != nil {
 		return "", "", e
 	}
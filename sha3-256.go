@@ -2,7 +2,6 @@ package validations
 
 import (
 	"encoding/hex"
-	"fmt"
 	"strings"
 
 	"golang.org/x/crypto/sha3"
@@ -11,10 +10,10 @@ import (
 func ValidateSHA3256(value interface{}) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(SHA3_256, value)
 	}
 	v = strings.ToLower(v)
-	e := ValidateRegEx(`^[0-9a-f]{64}$`, v)
+	e := matchRegEx(SHA3_256, hex64Regex, v)
 	if e != nil {
 		return "", "", e
 	}
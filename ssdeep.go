@@ -0,0 +1,31 @@
+package validations
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var ssdeepRegex = regexp.MustCompile(`^([0-9]+):([0-9A-Za-z+/]{0,64}):([0-9A-Za-z+/]{0,64})$`)
+
+// ValidateSSDEEP checks a context triggered piecewise hash, written as
+// blocksize:hash:hash where the block size is 3 times a power of 2.
+func ValidateSSDEEP(value interface{}) (string, string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return "", "", errNotString(SSDEEP, value)
+	}
+
+	v = strings.TrimSpace(v)
+	e := matchRegEx(SSDEEP, ssdeepRegex, v)
+	if e != nil {
+		return "", "", e
+	}
+
+	size, err := strconv.ParseUint(ssdeepRegex.FindStringSubmatch(v)[1], 10, 32)
+	if err != nil || size < 3 || size%3 != 0 || (size/3)&(size/3-1) != 0 {
+		return "", "", newValidationError(SSDEEP, CodeInvalid, value, "invalid ssdeep block size: %v", value)
+	}
+
+	return v, GenerateSHA3256(v), nil
+}
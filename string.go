@@ -1,18 +1,22 @@
 package validations
 
 import (
-	"fmt"
 	"strings"
 )
 
 func ValidateString(value interface{}, insensitive bool) (string, string, error) {
+	dataType := STR
+	if insensitive {
+		dataType = ISTR
+	}
+
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(dataType, value)
 	}
 
 	if v == "" {
-		return "", "", fmt.Errorf("value cannot be empty")
+		return "", "", newValidationError(dataType, CodeEmpty, value, "value cannot be empty")
 	}
 
 	if insensitive {
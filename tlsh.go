@@ -0,0 +1,29 @@
+package validations
+
+import (
+	"regexp"
+	"strings"
+)
+
+var tlshRegex = regexp.MustCompile(`^(T1)?[0-9A-F]{70}$`)
+
+// ValidateTLSH checks a TLSH digest, returning it upper cased with the T1
+// version prefix.
+func ValidateTLSH(value interface{}) (string, string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return "", "", errNotString(TLSH, value)
+	}
+
+	v = strings.ToUpper(v)
+	e := matchRegEx(TLSH, tlshRegex, v)
+	if e != nil {
+		return "", "", e
+	}
+
+	if !strings.HasPrefix(v, "T1") {
+		v = "T1" + v
+	}
+
+	return v, GenerateSHA3256(v), nil
+}
@@ -0,0 +1,136 @@
+package validations
+
+import (
+	"fmt"
+)
+
+// Result is the outcome of a typed validation.
+type Result[T any] struct {
+	Value    T      `json:"value"`
+	ID       string `json:"id"`
+	DataType string `json:"dataType"`
+}
+
+// Validator validates values of one data type keeping the static type of
+// the normalized value.
+type Validator[T any] interface {
+	Validate(value any) (Result[T], error)
+}
+
+// NewValidator returns a Validator for dataType backed by one of the
+// ValidateX functions, e.g. NewValidator(IP, ValidateIP).
+func NewValidator[T any](dataType string, fn func(value interface{}) (T, string, error)) Validator[T] {
+	return newFuncValidator(dataType, fn)
+}
+
+type funcValidator[T any] struct {
+	dataType string
+	fn       func(value interface{}) (T, string, error)
+}
+
+func newFuncValidator[T any](dataType string, fn func(value interface{}) (T, string, error)) *funcValidator[T] {
+	return &funcValidator[T]{dataType: dataType, fn: fn}
+}
+
+func (v *funcValidator[T]) Validate(value any) (Result[T], error) {
+	nv, id, err := v.fn(value)
+	if err != nil {
+		return Result[T]{}, err
+	}
+
+	return Result[T]{Value: nv, ID: id, DataType: v.dataType}, nil
+}
+
+func (v *funcValidator[T]) ValidateValue(value interface{}) (interface{}, string, error) {
+	return v.fn(value)
+}
+
+// valueValidator adapts a registered ValueValidator to Validator[T].
+type valueValidator[T any] struct {
+	dataType string
+	v        ValueValidator
+}
+
+func (v *valueValidator[T]) Validate(value any) (Result[T], error) {
+	nv, id, err := v.v.ValidateValue(value)
+	if err != nil {
+		return Result[T]{}, err
+	}
+
+	tv, ok := nv.(T)
+	if !ok {
+		return Result[T]{}, newValidationError(v.dataType, CodeTypeMismatch, value, "validator for %s returned %T instead of %T", v.dataType, nv, tv)
+	}
+
+	return Result[T]{Value: tv, ID: id, DataType: v.dataType}, nil
+}
+
+// typedValueValidator adapts a Validator[T] to ValueValidator so it can be
+// registered.
+type typedValueValidator[T any] struct {
+	v Validator[T]
+}
+
+func (v *typedValueValidator[T]) Validate(value any) (Result[T], error) {
+	return v.v.Validate(value)
+}
+
+func (v *typedValueValidator[T]) ValidateValue(value interface{}) (interface{}, string, error) {
+	res, err := v.v.Validate(value)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.Value, res.ID, nil
+}
+
+// RegisterTyped registers a Validator[T] for dataType in the registry.
+func RegisterTyped[T any](r *Registry, dataType string, v Validator[T]) {
+	if vv, ok := v.(ValueValidator); ok {
+		r.RegisterValidator(dataType, vv)
+		return
+	}
+
+	r.RegisterValidator(dataType, &typedValueValidator[T]{v: v})
+}
+
+// Typed returns the validator registered for dataType as a Validator[T].
+func Typed[T any](r *Registry, dataType string) (Validator[T], error) {
+	v, ok := r.Validator(dataType)
+	if !ok {
+		return nil, &ValidationError{DataType: dataType, Code: CodeUnknownValidator, Message: fmt.Sprintf("unknown validator for data type: %s", dataType)}
+	}
+
+	if tv, ok := v.(Validator[T]); ok {
+		return tv, nil
+	}
+
+	return &valueValidator[T]{dataType: dataType, v: v}, nil
+}
+
+// ValidateAs validates value against the definition t of the registry and
+// returns the normalized value as T.
+func ValidateAs[T any](r *Registry, value any, t string, opts ...Option) (Result[T], error) {
+	def, ok := r.Definition(t)
+	if !ok {
+		return Result[T]{}, &ValidationError{Type: t, Code: CodeUnknownType, Value: value, Message: fmt.Sprintf("unknown type: %s", t)}
+	}
+
+	nv, id, err := r.validateDefinition(value, def, opts)
+	if err != nil {
+		return Result[T]{}, err
+	}
+
+	tv, ok := nv.(T)
+	if !ok {
+		return Result[T]{}, &ValidationError{
+			Type:     def.Type,
+			DataType: def.DataType,
+			Code:     CodeTypeMismatch,
+			Value:    value,
+			Message:  fmt.Sprintf("validator for %s returned %T instead of %T", def.DataType, nv, tv),
+		}
+	}
+
+	return Result[T]{Value: tv, ID: id, DataType: def.DataType}, nil
+}
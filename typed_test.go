@@ -0,0 +1,57 @@
+package validations
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestTyped(t *testing.T) {
+	res, err := ValidateAs[string](DefaultRegistry, "8.8.8.8", "ip")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Value != "8.8.8.8" || res.DataType != IP || res.ID != GenerateSHA3256("8.8.8.8") {
+		t.Errorf("unexpected result %+v", res)
+	}
+
+	id := uuid.New()
+	u, err := ValidateAs[uuid.UUID](DefaultRegistry, id.String(), "breach")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.Value != id {
+		t.Errorf("ids are not equals")
+	}
+
+	_, err = ValidateAs[int64](DefaultRegistry, "8.8.8.8", "ip")
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Code != CodeTypeMismatch {
+		t.Errorf("expected a type mismatch error, got %v", err)
+	}
+
+	r := NewRegistry()
+	RegisterTyped(r, "Even", NewValidator("Even", func(value interface{}) (int64, string, error) {
+		v, _, err := ValidateInteger(value)
+		if err != nil || v%2 != 0 {
+			return 0, "", newValidationError("Even", CodeInvalid, value, "value is not even: %v", value)
+		}
+		return v, GenerateSHA3256("even"), nil
+	}))
+
+	v, err := Typed[int64](r, "Even")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n, err := v.Validate(int64(4)); err != nil || n.Value != 4 {
+		t.Errorf("unexpected result %+v: %v", n, err)
+	}
+
+	if _, err := Typed[int64](r, "Odd"); err == nil {
+		t.Error("this should return an error")
+	}
+}
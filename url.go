@@ -1,24 +1,231 @@
 package validations
 
 import (
-	"fmt"
+	"net"
 	"net/url"
+	"sort"
 	"strings"
 )
 
+// URLNormalization selects how URLs are normalized. The zero value, used by
+// ValidateURL and every URL Definition unless configured otherwise, only
+// lowercases the scheme and host. Canonical enables the RFC 3986 syntax and
+// scheme based normalization, which changes the IDs of the URLs it rewrites.
+type URLNormalization struct {
+	Canonical     bool
+	SortQuery     bool
+	StripTracking bool
+}
+
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+	"ftp":   "21",
+}
+
+var trackingParams = []string{"fbclid", "gclid", "dclid", "gbraid", "wbraid", "msclkid", "yclid", "igshid", "mc_cid", "mc_eid", "_ga", "_gl"}
+
 func ValidateURL(value interface{}) (string, string, error) {
+	return validateURL(value, Options{})
+}
+
+func validateURL(value interface{}, opts Options) (string, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return "", "", fmt.Errorf("value is not string: %v", value)
+		return "", "", errNotString(URL, value)
 	}
 
 	tmp, err := url.ParseRequestURI(v)
 	if err != nil {
-		return "", "", err
+		return "", "", wrapValidationError(URL, CodeInvalid, value, err)
+	}
+
+	if !opts.URLNormalization.Canonical {
+		tmp.Host = strings.ToLower(tmp.Host)
+		tmp.Scheme = strings.ToLower(tmp.Scheme)
+
+		surl := tmp.String()
+		return surl, GenerateSHA3256(surl), nil
 	}
-	tmp.Host = strings.ToLower(tmp.Host)
-	tmp.Scheme = strings.ToLower(tmp.Scheme)
 
-	surl := tmp.String()
+	surl, err := CanonicalizeURL(v, opts.URLNormalization)
+	if err != nil {
+		return "", "", wrapValidationError(URL, CodeInvalid, value, err)
+	}
 	return surl, GenerateSHA3256(surl), nil
 }
+
+// CanonicalizeURL returns the canonical form of an URL: lowercase scheme,
+// punycode host without trailing dot nor default port, normalized percent
+// encoding, no dot segments and no fragment.
+func CanonicalizeURL(s string, n URLNormalization) (string, error) {
+	v, _, _ := strings.Cut(s, "#")
+	u, err := url.ParseRequestURI(v)
+	if err != nil {
+		return "", wrapValidationError(URL, CodeInvalid, s, err)
+	}
+
+	return canonicalizeURL(u, n)
+}
+
+func canonicalizeURL(u *url.URL, n URLNormalization) (string, error) {
+	scheme := strings.ToLower(u.Scheme)
+	if u.Opaque != "" {
+		return scheme + ":" + normalizePercentEncoding(u.Opaque), nil
+	}
+
+	var b strings.Builder
+	if scheme != "" {
+		b.WriteString(scheme + ":")
+	}
+
+	if u.Host != "" {
+		host, err := canonicalHost(u.Hostname())
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString("//")
+		if u.User != nil {
+			b.WriteString(u.User.String() + "@")
+		}
+		b.WriteString(host)
+		if port := u.Port(); port != "" && port != defaultPorts[scheme] {
+			b.WriteString(":" + port)
+		}
+	}
+
+	path := removeDotSegments(normalizePercentEncoding(u.EscapedPath()))
+	if path == "" && u.Host != "" {
+		path = "/"
+	}
+	b.WriteString(path)
+
+	if query := canonicalQuery(u.RawQuery, n); query != "" {
+		b.WriteString("?" + query)
+	}
+
+	return b.String(), nil
+}
+
+func canonicalHost(host string) (string, error) {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+
+	if ip := net.ParseIP(host); ip != nil {
+		if ip.To4() == nil {
+			return "[" + ip.String() + "]", nil
+		}
+		return ip.String(), nil
+	}
+
+	return idnaProfile.ToASCII(host)
+}
+
+func canonicalQuery(query string, n URLNormalization) string {
+	if query == "" {
+		return ""
+	}
+
+	var params []string
+	for _, param := range strings.Split(query, "&") {
+		if param == "" {
+			continue
+		}
+
+		param = normalizePercentEncoding(param)
+		if n.StripTracking && isTrackingParam(param) {
+			continue
+		}
+		params = append(params, param)
+	}
+
+	if n.SortQuery {
+		sort.SliceStable(params, func(i, j int) bool {
+			return queryKey(params[i]) < queryKey(params[j])
+		})
+	}
+
+	return strings.Join(params, "&")
+}
+
+func queryKey(param string) string {
+	key, _, _ := strings.Cut(param, "=")
+	if k, err := url.QueryUnescape(key); err == nil {
+		return k
+	}
+	return key
+}
+
+func isTrackingParam(param string) bool {
+	key := strings.ToLower(queryKey(param))
+	return strings.HasPrefix(key, "utm_") || hasString(trackingParams, key)
+}
+
+// normalizePercentEncoding decodes the percent-encoded unreserved characters
+// and uppercases the remaining escapes.
+func normalizePercentEncoding(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '%' && i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]) {
+			c := unhex(s[i+1])<<4 | unhex(s[i+2])
+			if isURLUnreserved(c) {
+				b.WriteByte(c)
+			} else {
+				b.WriteString(strings.ToUpper(s[i : i+3]))
+			}
+			i += 2
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// removeDotSegments implements RFC 3986 section 5.2.4.
+func removeDotSegments(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	segments := strings.Split(path, "/")
+	var out []string
+	for i, seg := range segments {
+		last := i == len(segments)-1
+		switch seg {
+		case ".":
+			if last {
+				out = append(out, "")
+			}
+		case "..":
+			if len(out) > 1 {
+				out = out[:len(out)-1]
+			}
+			if last {
+				out = append(out, "")
+			}
+		default:
+			out = append(out, seg)
+		}
+	}
+	return strings.Join(out, "/")
+}
+
+func isURLUnreserved(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func unhex(c byte) byte {
+	switch {
+	case c >= 'a':
+		return c - 'a' + 10
+	case c >= 'A':
+		return c - 'A' + 10
+	}
+	return c - '0'
+}
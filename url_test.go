@@ -15,3 +15,73 @@ func TestValidateURL(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestCanonicalizeURL(t *testing.T) {
+	var cases = map[string]string{
+		"HTTP://EXAMPLE.com:80/a/../b":               "http://example.com/b",
+		"http://example.com/b":                       "http://example.com/b",
+		"https://example.com:443":                    "https://example.com/",
+		"https://example.com:8443/x":                 "https://example.com:8443/x",
+		"http://www.example.com./a/./b/../c/":        "http://www.example.com/a/c/",
+		"http://example.com/%7euser/%2fpath%3a":      "http://example.com/~user/%2Fpath%3A",
+		"http://bücher.de/straße":                    "http://xn--bcher-kva.de/stra%C3%9Fe",
+		"http://example.com/page#section":            "http://example.com/page",
+		"http://user:pass@[2001:DB8::1]:80/?b=2&a=1": "http://user:pass@[2001:db8::1]/?b=2&a=1",
+		"http://example.com/a/b/../../../c?q=%7e%2a": "http://example.com/c?q=~%2A",
+	}
+
+	for in, out := range cases {
+		u, err := CanonicalizeURL(in, URLNormalization{Canonical: true})
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+
+		if u != out {
+			t.Errorf("%s: expected %s, got %s", in, out, u)
+		}
+	}
+
+	u, err := CanonicalizeURL("https://example.com/?utm_source=x&b=2&fbclid=y&a=1&a=0", URLNormalization{Canonical: true, SortQuery: true, StripTracking: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u != "https://example.com/?a=1&a=0&b=2" {
+		t.Errorf("unexpected query normalization: %s", u)
+	}
+}
+
+func TestURLDefinition(t *testing.T) {
+	canonical := WithURLNormalization(URLNormalization{Canonical: true})
+
+	_, id1, err := ValidateValue("http://EXAMPLE.com:80/a/../b", "url", canonical)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, id2, err := ValidateValue("http://example.com/b", "url", canonical)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id1 != id2 {
+		t.Errorf("equivalent URLs have different IDs")
+	}
+
+	const in = "http://EXAMPLE.com:80/a/../b"
+	v, id, err := ValidateURL(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v != "http://example.com:80/a/../b" {
+		t.Errorf("URLs should not be canonicalized by default, got %v", v)
+	}
+
+	for _, typ := range []string{"url", "link", "file-data"} {
+		if _, tid, err := ValidateValue(in, typ); err != nil || tid != id {
+			t.Errorf("%s: expected the ID of ValidateURL, got %s %v", typ, tid, err)
+		}
+	}
+}
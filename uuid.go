@@ -1,7 +1,6 @@
 package validations
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/google/uuid"
@@ -10,12 +9,12 @@ import (
 func ValidateUUID(value interface{}) (uuid.UUID, string, error) {
 	v, ok := value.(string)
 	if !ok {
-		return uuid.UUID{}, "", fmt.Errorf("value is not string: %v", value)
+		return uuid.UUID{}, "", errNotString(UUID, value)
 	}
 
 	u, err := uuid.Parse(strings.ToLower(v))
 	if err != nil {
-		return uuid.UUID{}, "", err
+		return uuid.UUID{}, "", wrapValidationError(UUID, CodeInvalid, value, err)
 	}
 
 	return u, GenerateSHA3256(u.String()), nil
@@ -0,0 +1,66 @@
+package validations
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var xmrPrefixes = map[uint64]CryptoAddress{
+	18: {Network: Mainnet, Kind: "standard"},
+	19: {Network: Mainnet, Kind: "integrated"},
+	42: {Network: Mainnet, Kind: "subaddress"},
+	53: {Network: Testnet, Kind: "standard"},
+	54: {Network: Testnet, Kind: "integrated"},
+	63: {Network: Testnet, Kind: "subaddress"},
+	24: {Network: Stagenet, Kind: "standard"},
+	25: {Network: Stagenet, Kind: "integrated"},
+	36: {Network: Stagenet, Kind: "subaddress"},
+}
+
+// ParseXMRAddress parses a Monero standard, integrated or subaddress.
+func ParseXMRAddress(s string) (CryptoAddress, error) {
+	b, ok := moneroBase58Decode(s)
+	if !ok || len(b) < 5 {
+		return CryptoAddress{}, newValidationError(XMR, CodeInvalid, s, "invalid monero address: %s", s)
+	}
+
+	data, checksum := b[:len(b)-4], b[len(b)-4:]
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(data)
+	if !bytes.Equal(hash.Sum(nil)[:4], checksum) {
+		return CryptoAddress{}, newValidationError(XMR, CodeBadChecksum, s, "invalid monero address checksum: %s", s)
+	}
+
+	prefix, n := binary.Uvarint(data)
+	addr, ok := xmrPrefixes[prefix]
+	if n <= 0 || !ok {
+		return CryptoAddress{}, newValidationError(XMR, CodeInvalid, s, "invalid monero address prefix: %s", s)
+	}
+
+	keys := 64
+	if addr.Kind == "integrated" {
+		keys += 8
+	}
+	if len(data)-n != keys {
+		return CryptoAddress{}, newValidationError(XMR, CodeInvalid, s, "invalid monero address length: %s", s)
+	}
+
+	addr.Address = s
+	return addr, nil
+}
+
+func ValidateXMR(value interface{}) (string, string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return "", "", errNotString(XMR, value)
+	}
+
+	addr, err := ParseXMRAddress(v)
+	if err != nil {
+		return "", "", err
+	}
+
+	return addr.Address, GenerateSHA3256(addr.Address), nil
+}